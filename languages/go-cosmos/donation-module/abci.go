@@ -0,0 +1,86 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker runs the module's start-of-block processing
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	// End a scheduled pause before this block's donations are processed
+	if err := k.autoUnpause(ctx); err != nil {
+		ctx.Logger().Error("failed to unpause", "err", err)
+	}
+
+	// Extend nearly funded campaigns before this block's donations are
+	// checked against their deadlines
+	if err := k.extendCampaigns(ctx); err != nil {
+		ctx.Logger().Error("failed to extend campaigns", "err", err)
+	}
+}
+
+// EndBlocker runs the module's end-of-block processing
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	// Activate announced param changes before anything reads the params
+	if err := k.applyPendingParams(ctx); err != nil {
+		ctx.Logger().Error("failed to apply pending params", "err", err)
+	}
+
+	params := k.GetParams(ctx)
+
+	// Record donations whose clawback window has closed
+	if err := k.releaseEscrows(ctx); err != nil {
+		ctx.Logger().Error("failed to release escrowed donations", "err", err)
+	}
+
+	// Close campaigns past their deadline, then pay out successful ones
+	// whose payout delay has passed
+	if err := k.finalizeCampaigns(ctx); err != nil {
+		ctx.Logger().Error("failed to finalize campaigns", "err", err)
+	}
+	if err := k.payCampaigns(ctx); err != nil {
+		ctx.Logger().Error("failed to pay out campaigns", "err", err)
+	}
+
+	// Anchor the donor set at each epoch boundary
+	if params.AnchorEpochBlocks > 0 && uint64(ctx.BlockHeight())%params.AnchorEpochBlocks == 0 {
+		if _, err := k.AnchorDonorSet(ctx, uint64(ctx.BlockHeight())/params.AnchorEpochBlocks); err != nil {
+			ctx.Logger().Error("failed to anchor donor set", "err", err)
+		}
+	}
+
+	// Release unbonded funds; the staking module's EndBlocker must run
+	// first so they are back in the module account
+	if err := k.completeUnbondings(ctx); err != nil {
+		ctx.Logger().Error("failed to complete unbondings", "err", err)
+	}
+
+	k.pruneExpiredBoosts(ctx)
+
+	// Trigger challenge matches whose campaigns reached their targets in
+	// this block, and refund those past their deadline
+	k.evaluateChallenges(ctx)
+
+	// Pay out unlocked vesting tranches
+	k.releaseVesting(ctx)
+
+	// Return rewards left unclaimed past their claim period to the pool
+	if err := k.expireRewards(ctx); err != nil {
+		ctx.Logger().Error("failed to expire rewards", "err", err)
+	}
+
+	// Pay the scheduled share of the balance to the beneficiaries
+	if params.DistributionSchedule.IsDue(ctx.BlockHeight()) {
+		if err := k.runScheduledDistribution(ctx, params.DistributionSchedule); err != nil {
+			ctx.Logger().Error("failed to run scheduled distribution", "err", err)
+		}
+	}
+
+	// Snapshot the donor set last, once nothing else changes it this block
+	if err := k.takeDueSnapshots(ctx); err != nil {
+		ctx.Logger().Error("failed to take donor snapshots", "err", err)
+	}
+
+	if state, found := k.GetState(ctx); found {
+		setStateGauges(state)
+	}
+}
@@ -0,0 +1,94 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferAdmin proposes a new admin. The handover completes only when the
+// proposed address calls AcceptAdmin; proposing again replaces the pending
+// admin and proposing the current admin cancels the handover.
+func (k Keeper) TransferAdmin(ctx sdk.Context, admin string, newAdmin string) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if admin != state.Admin {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can transfer admin")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(newAdmin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if newAdmin == state.Admin {
+		state.PendingAdmin = ""
+	} else {
+		state.PendingAdmin = newAdmin
+	}
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, admin, AuditAdminTransferProposed, map[string]interface{}{
+		"pending_admin": state.PendingAdmin,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventAdminTransferProposed{
+		Admin:        admin,
+		PendingAdmin: state.PendingAdmin,
+		Timestamp:    ctx.BlockTime().Unix(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AcceptAdmin completes a pending admin transfer
+func (k Keeper) AcceptAdmin(ctx sdk.Context, sender string) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if state.PendingAdmin == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no pending admin transfer")
+	}
+
+	if sender != state.PendingAdmin {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the pending admin can accept")
+	}
+
+	previous := state.Admin
+	state.Admin = state.PendingAdmin
+	state.PendingAdmin = ""
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, sender, AuditAdminTransferAccepted, map[string]interface{}{
+		"previous_admin": previous,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventAdminTransferred{
+		PreviousAdmin: previous,
+		Admin:         state.Admin,
+		Timestamp:     ctx.BlockTime().Unix(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPendingAdmin returns the proposed admin awaiting acceptance, if any
+func (k Keeper) GetPendingAdmin(ctx sdk.Context) (string, bool) {
+	state, found := k.GetState(ctx)
+	if !found || state.PendingAdmin == "" {
+		return "", false
+	}
+
+	return state.PendingAdmin, true
+}
@@ -0,0 +1,127 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DonorSetAnchor commits to the full donor set at the end of an epoch
+type DonorSetAnchor struct {
+	Epoch      uint64
+	Height     int64
+	Root       []byte
+	DonorCount uint64
+}
+
+// GetDonorSetAnchorKey returns the store key for an epoch's anchor
+func GetDonorSetAnchorKey(epoch uint64) []byte {
+	return append(DonorSetAnchorKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// DonorLeaf returns the Merkle leaf data committed for a donor:
+// address, lifetime total and tier, separated by '|'
+func DonorLeaf(donor DonorRecord) []byte {
+	return []byte(fmt.Sprintf("%s|%s|%d", donor.Address, donor.TotalDonated.String(), donor.Tier))
+}
+
+// donorLeaves returns the Merkle leaf hashes of donors, in store order
+func donorLeaves(donors []DonorRecord) [][]byte {
+	leaves := make([][]byte, len(donors))
+	for i, donor := range donors {
+		leaves[i] = merkleLeafHash(DonorLeaf(donor))
+	}
+	return leaves
+}
+
+// AnchorDonorSet computes the donor-set Merkle root and stores it for the
+// current epoch. Called from EndBlocker at each epoch boundary.
+func (k Keeper) AnchorDonorSet(ctx sdk.Context, epoch uint64) (DonorSetAnchor, error) {
+	donors := k.GetAllDonors(ctx)
+
+	anchor := DonorSetAnchor{
+		Epoch:      epoch,
+		Height:     ctx.BlockHeight(),
+		Root:       merkleRoot(donorLeaves(donors)),
+		DonorCount: uint64(len(donors)),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&anchor)
+	store.Set(GetDonorSetAnchorKey(epoch), bz)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventDonorSetAnchored{
+		Epoch:      epoch,
+		Height:     anchor.Height,
+		Root:       anchor.Root,
+		DonorCount: anchor.DonorCount,
+	}); err != nil {
+		return anchor, err
+	}
+
+	return anchor, nil
+}
+
+// GetDonorSetAnchor retrieves the anchor of an epoch
+func (k Keeper) GetDonorSetAnchor(ctx sdk.Context, epoch uint64) (DonorSetAnchor, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDonorSetAnchorKey(epoch))
+	if bz == nil {
+		return DonorSetAnchor{}, false
+	}
+
+	var anchor DonorSetAnchor
+	k.cdc.MustUnmarshal(bz, &anchor)
+	return anchor, true
+}
+
+// GetLatestDonorSetAnchor retrieves the most recent anchor
+func (k Keeper) GetLatestDonorSetAnchor(ctx sdk.Context) (DonorSetAnchor, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, DonorSetAnchorKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return DonorSetAnchor{}, false
+	}
+
+	var anchor DonorSetAnchor
+	k.cdc.MustUnmarshal(iterator.Value(), &anchor)
+	return anchor, true
+}
+
+// GetDonorSetProof builds the membership proof of a donor in an epoch's
+// donor set. The set is rebuilt from the state at the anchor height, so ctx
+// must be a query context at that height (see checkQueryHeight) and proofs
+// can be generated as long as the node retains that version; once issued
+// they verify against the stored root indefinitely.
+func (k Keeper) GetDonorSetProof(
+	ctx sdk.Context,
+	epoch uint64,
+	addr string,
+) (DonorRecord, []MerkleProofStep, error) {
+	anchor, found := k.GetDonorSetAnchor(ctx, epoch)
+	if !found {
+		return DonorRecord{}, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no anchor for epoch %d", epoch)
+	}
+
+	if err := checkQueryHeight(ctx, anchor.Height); err != nil {
+		return DonorRecord{}, nil, err
+	}
+
+	donors := k.GetAllDonors(ctx)
+	leaves := donorLeaves(donors)
+	index := -1
+	for i, donor := range donors {
+		if donor.Address == addr {
+			index = i
+		}
+	}
+
+	if index < 0 {
+		return DonorRecord{}, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "%s not in donor set of epoch %d", addr, epoch)
+	}
+
+	return donors[index], merkleProof(leaves, index), nil
+}
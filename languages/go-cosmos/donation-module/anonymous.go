@@ -0,0 +1,55 @@
+package donation
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// AnonymousDonor replaces the address of anonymous donors in events and
+// public queries
+const AnonymousDonor = "anonymous"
+
+// Public returns the record as shown in public queries: anonymous donors
+// have their address replaced with AnonymousDonor
+func (d DonorRecord) Public() DonorRecord {
+	if d.Anonymous {
+		d.Address = AnonymousDonor
+	}
+	return d
+}
+
+// Public returns the donation as shown in public queries: anonymous
+// donations have their donor replaced with AnonymousDonor
+func (d Donation) Public() Donation {
+	if d.Anonymous {
+		d.Donor = AnonymousDonor
+	}
+	return d
+}
+
+// publicDonor returns the address to show for donor in events
+func publicDonor(donor DonorRecord) string {
+	return donor.Public().Address
+}
+
+// QueryDonors returns a page of donor records for public listing, with
+// anonymous donors redacted. Internal consumers use GetAllDonors.
+func (k Keeper) QueryDonors(ctx sdk.Context, pageReq *query.PageRequest) ([]DonorRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DonorKeyPrefix)
+
+	donors := []DonorRecord{}
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var donor DonorRecord
+		if err := k.cdc.Unmarshal(value, &donor); err != nil {
+			return err
+		}
+		donors = append(donors, k.withEffectiveTier(ctx, donor).Public())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return donors, pageRes, nil
+}
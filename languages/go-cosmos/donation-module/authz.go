@@ -0,0 +1,76 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &DonateAuthorization{}
+
+// DonateAuthorization lets a grantee, such as a custodian or a bot, execute
+// MsgDonate on the granter's behalf through x/authz. SpendLimit is the total
+// the grantee may donate and shrinks with every donation; MaxPerDonation
+// caps a single donation in each listed denom.
+type DonateAuthorization struct {
+	SpendLimit     sdk.Coins
+	MaxPerDonation sdk.Coins // optional; unlisted denoms are bound only by SpendLimit
+}
+
+// NewDonateAuthorization returns a DonateAuthorization
+func NewDonateAuthorization(spendLimit, maxPerDonation sdk.Coins) *DonateAuthorization {
+	return &DonateAuthorization{
+		SpendLimit:     spendLimit,
+		MaxPerDonation: maxPerDonation,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization
+func (a DonateAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgDonate{})
+}
+
+// Accept implements authz.Authorization. The grant is used up, and deleted,
+// once the spend limit reaches zero.
+func (a DonateAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	donate, ok := msg.(*MsgDonate)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	for _, coin := range donate.Amount {
+		if limit := a.MaxPerDonation.AmountOf(coin.Denom); limit.IsPositive() && coin.Amount.GT(limit) {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"donation of %s exceeds the per-donation maximum of %s%s", coin, limit, coin.Denom,
+			)
+		}
+	}
+
+	remaining, negative := a.SpendLimit.SafeSub(donate.Amount...)
+	if negative {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"donation of %s exceeds the remaining spend limit %s", donate.Amount, a.SpendLimit,
+		)
+	}
+	if remaining.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: &DonateAuthorization{SpendLimit: remaining, MaxPerDonation: a.MaxPerDonation},
+	}, nil
+}
+
+// ValidateBasic implements authz.Authorization
+func (a DonateAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || a.SpendLimit.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "donate authorization needs a positive spend limit")
+	}
+	if !a.MaxPerDonation.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid per-donation maximum %s", a.MaxPerDonation)
+	}
+	return nil
+}
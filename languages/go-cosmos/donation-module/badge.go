@@ -0,0 +1,120 @@
+package donation
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// BadgeClassID is the x/nft class holding donor tier badges
+const BadgeClassID = "donationbadge"
+
+// BadgeData is stored in each badge NFT's Data field
+type BadgeData struct {
+	Tier         DonorTier
+	TotalDonated sdk.Coins
+	AwardedAt    int64 // unix seconds the current tier was reached
+}
+
+// GetBadgeID returns the NFT ID of a donor's badge
+func GetBadgeID(donor string) string {
+	return "badge-" + donor
+}
+
+// updateBadge mints a donor's badge on reaching their first tier and
+// upgrades it in place on later tier changes
+func (k Keeper) updateBadge(ctx sdk.Context, donor DonorRecord) error {
+	if donor.Tier == TierNone {
+		return nil
+	}
+
+	// Older IBC donations were credited to the counterparty sender, which
+	// has no local account to hold the badge
+	owner, err := sdk.AccAddressFromBech32(donor.Address)
+	if err != nil {
+		return nil
+	}
+
+	if err := k.ensureBadgeClass(ctx); err != nil {
+		return err
+	}
+
+	data, err := codectypes.NewAnyWithValue(&BadgeData{
+		Tier:         donor.Tier,
+		TotalDonated: donor.TotalDonated,
+		AwardedAt:    ctx.BlockTime().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	token := nft.NFT{
+		ClassId: BadgeClassID,
+		Id:      GetBadgeID(donor.Address),
+		Uri:     fmt.Sprintf("donation://badge/%d", donor.Tier),
+		Data:    data,
+	}
+
+	if k.nftKeeper.HasNFT(ctx, BadgeClassID, token.Id) {
+		return k.nftKeeper.Update(ctx, token)
+	}
+
+	return k.nftKeeper.Mint(ctx, token, owner)
+}
+
+// ensureBadgeClass creates the badge class the first time a badge is minted
+func (k Keeper) ensureBadgeClass(ctx sdk.Context) error {
+	if k.nftKeeper.HasClass(ctx, BadgeClassID) {
+		return nil
+	}
+
+	return k.nftKeeper.SaveClass(ctx, nft.Class{
+		Id:          BadgeClassID,
+		Name:        "Donor Tier Badge",
+		Symbol:      "DBADGE",
+		Description: "Non-transferable badge recording a donor's tier",
+	})
+}
+
+// BadgeTransferDecorator rejects x/nft sends of donor badges, making them
+// non-transferable. It must be added to the app's ante handler chain.
+type BadgeTransferDecorator struct{}
+
+// NewBadgeTransferDecorator creates a new BadgeTransferDecorator
+func NewBadgeTransferDecorator() BadgeTransferDecorator {
+	return BadgeTransferDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d BadgeTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := rejectBadgeSends(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func rejectBadgeSends(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *nft.MsgSend:
+			if m.ClassId == BadgeClassID {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "donor badges are non-transferable")
+			}
+		case *authz.MsgExec:
+			inner, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := rejectBadgeSends(inner); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
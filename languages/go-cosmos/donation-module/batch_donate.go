@@ -0,0 +1,69 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxBatchDonationEntries bounds the entries of a single batch donation
+const MaxBatchDonationEntries = 50
+
+// BatchDonationEntry is one gift of a batch donation; a zero CampaignID
+// makes a general donation
+type BatchDonationEntry struct {
+	CampaignID uint64
+	Amount     sdk.Coins
+}
+
+// BatchDonate processes entries as one atomic donation: either every entry
+// is recorded or none is. Each entry goes through the same checks as a
+// single donation, but only one aggregated EventBatchDonation is emitted
+// in place of the per-entry events. The coins must already be in the
+// module account.
+func (k Keeper) BatchDonate(
+	ctx sdk.Context,
+	donor string,
+	entries []BatchDonationEntry,
+	memo string,
+	anonymous bool,
+) error {
+	if len(entries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty batch donation")
+	}
+	if len(entries) > MaxBatchDonationEntries {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch donation has %d entries, max %d", len(entries), MaxBatchDonationEntries)
+	}
+
+	// The cache context collects the per-entry events; they are dropped by
+	// swapping in a fresh event manager whose events are never written
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	total := sdk.NewCoins()
+	for i, entry := range entries {
+		var err error
+		if entry.CampaignID != 0 {
+			err = k.DonateToCampaign(cacheCtx, donor, entry.CampaignID, entry.Amount, memo, anonymous)
+		} else {
+			err = k.Donate(cacheCtx, donor, entry.Amount, memo, anonymous)
+		}
+		if err != nil {
+			return sdkerrors.Wrapf(err, "batch entry %d", i)
+		}
+		total = total.Add(entry.Amount...)
+	}
+
+	write()
+
+	donorRecord, _ := k.GetDonor(ctx, donor)
+	if err := ctx.EventManager().EmitTypedEvent(&EventBatchDonation{
+		Donor:   publicDonor(donorRecord),
+		Entries: entries,
+		Total:   total,
+		Tier:    donorRecord.Tier,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
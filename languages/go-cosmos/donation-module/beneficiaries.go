@@ -0,0 +1,183 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxBeneficiaries bounds the registered beneficiaries
+const MaxBeneficiaries = 20
+
+// Beneficiary receives a weighted share of distributed withdrawals
+type Beneficiary struct {
+	Address string
+	Weight  uint64
+}
+
+// BeneficiarySet is the stored list of beneficiaries, in registration order
+type BeneficiarySet struct {
+	Beneficiaries []Beneficiary
+}
+
+// ValidateBeneficiaries rejects invalid addresses, zero weights and
+// duplicates. An empty list is valid and clears the beneficiaries.
+func ValidateBeneficiaries(beneficiaries []Beneficiary) error {
+	if len(beneficiaries) > MaxBeneficiaries {
+		return fmt.Errorf("%d beneficiaries, max %d", len(beneficiaries), MaxBeneficiaries)
+	}
+
+	seen := make(map[string]bool, len(beneficiaries))
+	for _, b := range beneficiaries {
+		if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+			return fmt.Errorf("invalid beneficiary %s: %w", b.Address, err)
+		}
+		if b.Weight == 0 {
+			return fmt.Errorf("beneficiary %s has zero weight", b.Address)
+		}
+		if seen[b.Address] {
+			return fmt.Errorf("duplicate beneficiary %s", b.Address)
+		}
+		seen[b.Address] = true
+	}
+
+	return nil
+}
+
+// SplitByWeight splits amount across beneficiaries in proportion to their
+// weights. Each share is rounded down per denom and the rounding dust goes
+// to the first beneficiary, so the shares always add up to amount.
+func SplitByWeight(amount sdk.Coins, beneficiaries []Beneficiary) []sdk.Coins {
+	shares := make([]sdk.Coins, len(beneficiaries))
+	if len(beneficiaries) == 0 {
+		return shares
+	}
+
+	totalWeight := sdk.ZeroInt()
+	for _, b := range beneficiaries {
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(b.Weight))
+	}
+
+	for i := range shares {
+		shares[i] = sdk.NewCoins()
+	}
+
+	for _, coin := range amount {
+		remaining := coin.Amount
+		for i, b := range beneficiaries {
+			share := coin.Amount.Mul(sdk.NewIntFromUint64(b.Weight)).Quo(totalWeight)
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, share))
+			remaining = remaining.Sub(share)
+		}
+		shares[0] = shares[0].Add(sdk.NewCoin(coin.Denom, remaining))
+	}
+
+	return shares
+}
+
+// GetBeneficiaries returns the registered beneficiaries
+func (k Keeper) GetBeneficiaries(ctx sdk.Context) []Beneficiary {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(BeneficiariesKey)
+	if bz == nil {
+		return []Beneficiary{}
+	}
+
+	var set BeneficiarySet
+	k.cdc.MustUnmarshal(bz, &set)
+	return set.Beneficiaries
+}
+
+func (k Keeper) setBeneficiaries(ctx sdk.Context, beneficiaries []Beneficiary) {
+	store := ctx.KVStore(k.storeKey)
+	if len(beneficiaries) == 0 {
+		store.Delete(BeneficiariesKey)
+		return
+	}
+
+	set := BeneficiarySet{Beneficiaries: beneficiaries}
+	store.Set(BeneficiariesKey, k.cdc.MustMarshal(&set))
+}
+
+// SetBeneficiaries replaces the registered beneficiaries; an empty list
+// clears them. Only ADMIN may change them.
+func (k Keeper) SetBeneficiaries(ctx sdk.Context, sender string, beneficiaries []Beneficiary) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can set beneficiaries")
+	}
+
+	if err := ValidateBeneficiaries(beneficiaries); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.setBeneficiaries(ctx, beneficiaries)
+
+	if err := k.recordAudit(ctx, sender, AuditSetBeneficiaries, map[string]interface{}{
+		"beneficiaries": beneficiaries,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventBeneficiariesSet{
+		Sender:        sender,
+		Beneficiaries: beneficiaries,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Distribute withdraws amount split across the registered beneficiaries.
+// It takes the same approval path as Withdraw.
+func (k Keeper) Distribute(ctx sdk.Context, sender string, amount sdk.Coins) error {
+	return k.Withdraw(ctx, sender, amount, "")
+}
+
+// payBeneficiaries sends each beneficiary its share of amount
+func (k Keeper) payBeneficiaries(ctx sdk.Context, amount sdk.Coins) error {
+	beneficiaries := k.GetBeneficiaries(ctx)
+	if len(beneficiaries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no beneficiaries registered")
+	}
+
+	for i, share := range SplitByWeight(amount, beneficiaries) {
+		if share.IsZero() {
+			continue
+		}
+
+		beneficiary := beneficiaries[i]
+		addr, err := sdk.AccAddressFromBech32(beneficiary.Address)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		if err := k.checkStrictRecipient(ctx, beneficiary.Address); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, addr, share); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&EventBeneficiaryPaid{
+			Beneficiary: beneficiary.Address,
+			Amount:      share,
+			Weight:      beneficiary.Weight,
+		}); err != nil {
+			return err
+		}
+
+		if err := k.afterWithdrawal(ctx, beneficiary.Address, share); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
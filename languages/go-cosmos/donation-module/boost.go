@@ -0,0 +1,264 @@
+package donation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Campaign ranking limits
+const (
+	DefaultRankingLimit = 20
+	MaxRankingLimit     = 100
+)
+
+// BoostParams prices sponsored campaign boosts
+type BoostParams struct {
+	Denom       string // boosts are paid in this denom; empty disables boosts
+	MaxDuration int64  // seconds
+	Burn        bool   // burn payments instead of funding the community pool
+}
+
+// IsSet reports whether boosts are enabled
+func (p BoostParams) IsSet() bool {
+	return p.Denom != ""
+}
+
+// Validate performs basic validation of the boost params
+func (p BoostParams) Validate() error {
+	if !p.IsSet() {
+		return nil
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid boost denom: %w", err)
+	}
+	if p.MaxDuration <= 0 {
+		return fmt.Errorf("max boost duration must be positive")
+	}
+	return nil
+}
+
+// Boost is a sponsor-paid, time-limited ranking boost for a campaign. Its
+// weight is the amount paid and decays linearly to zero at Expiry.
+type Boost struct {
+	ID         uint64
+	CampaignID uint64
+	Sponsor    string
+	Amount     sdk.Coin
+	Start      int64 // unix seconds
+	Expiry     int64 // unix seconds
+}
+
+// Weight returns the boost's decayed weight at now
+func (b Boost) Weight(now int64) sdk.Dec {
+	if now >= b.Expiry || b.Expiry <= b.Start {
+		return sdk.ZeroDec()
+	}
+	if now < b.Start {
+		now = b.Start
+	}
+	return sdk.NewDecFromInt(b.Amount.Amount).MulInt64(b.Expiry - now).QuoInt64(b.Expiry - b.Start)
+}
+
+// CampaignRank is a campaign's position in the boosted ranking
+type CampaignRank struct {
+	CampaignID uint64
+	Raised     sdk.Int // raised in the boost denom
+	Boost      sdk.Dec // decayed weight of the active boosts
+	Score      sdk.Dec // Raised + Boost
+}
+
+// GetBoostKey returns the store key of a boost, grouped by campaign
+func GetBoostKey(campaignID, boostID uint64) []byte {
+	return append(GetCampaignBoostsPrefix(campaignID), sdk.Uint64ToBigEndian(boostID)...)
+}
+
+// GetCampaignBoostsPrefix returns the prefix of a campaign's boosts
+func GetCampaignBoostsPrefix(campaignID uint64) []byte {
+	return append(BoostKeyPrefix, sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetBoostExpiryKey returns the key of a boost in the expiry queue
+func GetBoostExpiryKey(expiry int64, campaignID, boostID uint64) []byte {
+	key := append(BoostExpiryQueuePrefix, sdk.Uint64ToBigEndian(uint64(expiry))...)
+	key = append(key, sdk.Uint64ToBigEndian(campaignID)...)
+	return append(key, sdk.Uint64ToBigEndian(boostID)...)
+}
+
+// BoostCampaign buys a boost for an active campaign lasting duration
+// seconds. The payment goes to the community pool, or is burned with
+// BoostParams.Burn; it is not a donation.
+func (k Keeper) BoostCampaign(ctx sdk.Context, sponsor string, campaignID uint64, amount sdk.Coin, duration int64) (Boost, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return Boost{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	params := k.GetParams(ctx).Boosts
+	if !params.IsSet() {
+		return Boost{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "boosts are disabled")
+	}
+
+	if amount.Denom != params.Denom || !amount.Amount.IsPositive() {
+		return Boost{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "boosts are paid in %s", params.Denom)
+	}
+
+	if duration <= 0 || duration > params.MaxDuration {
+		return Boost{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "boost duration must be 1 to %d seconds", params.MaxDuration)
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return Boost{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+	if campaign.Archived || campaign.IsFinished(ctx.BlockTime().Unix()) {
+		return Boost{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has ended", campaignID)
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return Boost{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	payment := sdk.NewCoins(amount)
+	if params.Burn {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, ModuleName, payment); err != nil {
+			return Boost{}, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, ModuleName, payment); err != nil {
+			return Boost{}, err
+		}
+	} else if err := k.distrKeeper.FundCommunityPool(ctx, payment, sponsorAddr); err != nil {
+		return Boost{}, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	boost := Boost{
+		ID:         k.nextBoostID(ctx),
+		CampaignID: campaignID,
+		Sponsor:    sponsor,
+		Amount:     amount,
+		Start:      now,
+		Expiry:     now + duration,
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetBoostKey(campaignID, boost.ID), k.cdc.MustMarshal(&boost))
+	store.Set(GetBoostExpiryKey(boost.Expiry, campaignID, boost.ID), []byte{})
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignBoosted{
+		BoostID:    boost.ID,
+		CampaignID: campaignID,
+		Sponsor:    sponsor,
+		Amount:     amount,
+		Expiry:     boost.Expiry,
+		Burned:     params.Burn,
+	}); err != nil {
+		return Boost{}, err
+	}
+
+	return boost, nil
+}
+
+// GetCampaignBoosts returns the boosts of a campaign that have not been
+// pruned yet
+func (k Keeper) GetCampaignBoosts(ctx sdk.Context, campaignID uint64) []Boost {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetCampaignBoostsPrefix(campaignID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	boosts := []Boost{}
+	for ; iterator.Valid(); iterator.Next() {
+		var boost Boost
+		k.cdc.MustUnmarshal(iterator.Value(), &boost)
+		boosts = append(boosts, boost)
+	}
+
+	return boosts
+}
+
+// QueryCampaignRanking ranks the active campaigns by amount raised in the
+// boost denom plus the decayed weight of their boosts, highest first. A
+// zero limit returns DefaultRankingLimit campaigns; limits are capped at
+// MaxRankingLimit.
+func (k Keeper) QueryCampaignRanking(ctx sdk.Context, limit uint32) []CampaignRank {
+	if limit == 0 {
+		limit = DefaultRankingLimit
+	}
+	if limit > MaxRankingLimit {
+		limit = MaxRankingLimit
+	}
+
+	denom := k.GetParams(ctx).Boosts.Denom
+	now := ctx.BlockTime().Unix()
+
+	ranks := []CampaignRank{}
+	for _, campaign := range k.GetActiveCampaigns(ctx) {
+		if campaign.IsFinished(now) {
+			continue
+		}
+
+		boost := sdk.ZeroDec()
+		for _, b := range k.GetCampaignBoosts(ctx, campaign.ID) {
+			boost = boost.Add(b.Weight(now))
+		}
+
+		raised := campaign.Raised.AmountOf(denom)
+		ranks = append(ranks, CampaignRank{
+			CampaignID: campaign.ID,
+			Raised:     raised,
+			Boost:      boost,
+			Score:      boost.Add(sdk.NewDecFromInt(raised)),
+		})
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		if !ranks[i].Score.Equal(ranks[j].Score) {
+			return ranks[i].Score.GT(ranks[j].Score)
+		}
+		return ranks[i].CampaignID < ranks[j].CampaignID
+	})
+
+	if uint32(len(ranks)) > limit {
+		ranks = ranks[:limit]
+	}
+	return ranks
+}
+
+// pruneExpiredBoosts deletes boosts that have fully decayed
+func (k Keeper) pruneExpiredBoosts(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, BoostExpiryQueuePrefix)
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if int64(sdk.BigEndianToUint64(key[len(BoostExpiryQueuePrefix):len(BoostExpiryQueuePrefix)+8])) > now {
+			break
+		}
+		expired = append(expired, append([]byte{}, key...))
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+
+		ids := key[len(BoostExpiryQueuePrefix)+8:]
+		store.Delete(GetBoostKey(sdk.BigEndianToUint64(ids[:8]), sdk.BigEndianToUint64(ids[8:])))
+	}
+}
+
+// nextBoostID returns the next boost ID and advances the sequence
+func (k Keeper) nextBoostID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(BoostSeqKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(BoostSeqKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
@@ -0,0 +1,46 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// validateBurnRate checks that a burn rate is in [0, 1); nil means no burn
+func validateBurnRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return nil
+	}
+	if rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("burn rate must be in [0, 1)")
+	}
+	return nil
+}
+
+// BurnAmount returns the part of amount burned at rate, rounded down per
+// denom, so a donation is never burned in full
+func BurnAmount(amount sdk.Coins, rate sdk.Dec) sdk.Coins {
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	burned, _ := sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(rate).TruncateDecimal()
+	return burned
+}
+
+// burnDonation burns Params.BurnRate of a donation from the module account
+// and returns the burned coins. The donor is still credited in full; the
+// burned coins are tracked in DonationState.TotalBurned and are no longer
+// withdrawable.
+func (k Keeper) burnDonation(ctx sdk.Context, amount sdk.Coins) (sdk.Coins, error) {
+	burned := BurnAmount(amount, k.GetParams(ctx).BurnRate)
+	if burned.IsZero() {
+		return burned, nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, ModuleName, burned); err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
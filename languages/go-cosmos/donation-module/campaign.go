@@ -0,0 +1,410 @@
+package donation
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Campaign is a fundraising drive that donations can be directed to
+type Campaign struct {
+	ID          uint64
+	Creator     string
+	Title       string
+	Beneficiary string
+	Goal        sdk.Coins
+	Raised      sdk.Coins
+	Deadline    int64 // unix seconds, 0 for open-ended
+	Archived    bool
+
+	// AcceptedDenoms restricts donations to these denoms, on top of the
+	// module-wide donation limits; empty accepts any denom with a limit
+	AcceptedDenoms []string
+
+	// ExtensionPolicy extends the deadline of a nearly funded campaign at
+	// expiry; Extensions counts the extensions applied so far
+	ExtensionPolicy ExtensionPolicy
+	Extensions      uint32
+
+	// Status is set by the EndBlocker once the deadline passes; a
+	// successful campaign is paid out at PayoutHeight, recording PaidOut
+	Status       CampaignStatus
+	PayoutHeight int64
+	PaidOut      sdk.Coins
+}
+
+// GetCampaignKey returns the store key for a campaign
+func GetCampaignKey(id uint64) []byte {
+	return append(CampaignKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetActiveCampaignKey returns the active-index key for a campaign
+func GetActiveCampaignKey(id uint64) []byte {
+	return append(ActiveCampaignKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// AcceptsDenom reports whether the campaign accepts denom. An accepted IBC
+// voucher matches either its ibc/{hash} denom or its base denom.
+func (c Campaign) AcceptsDenom(denom, baseDenom string) bool {
+	if len(c.AcceptedDenoms) == 0 {
+		return true
+	}
+	for _, accepted := range c.AcceptedDenoms {
+		if accepted == denom || (baseDenom != "" && accepted == baseDenom) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinished reports whether the campaign reached its goal or deadline
+func (c Campaign) IsFinished(now int64) bool {
+	if !c.Goal.IsZero() && c.Raised.IsAllGTE(c.Goal) {
+		return true
+	}
+	return c.Deadline > 0 && now >= c.Deadline
+}
+
+// CreateCampaign registers a new campaign and returns its ID
+func (k Keeper) CreateCampaign(
+	ctx sdk.Context,
+	creator string,
+	title string,
+	beneficiary string,
+	goal sdk.Coins,
+	deadline int64,
+	acceptedDenoms []string,
+) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if title == "" {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "campaign title required")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(beneficiary); err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !goal.IsValid() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid campaign goal")
+	}
+
+	if deadline != 0 && deadline <= ctx.BlockTime().Unix() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deadline must be in the future")
+	}
+
+	seen := make(map[string]bool, len(acceptedDenoms))
+	for _, denom := range acceptedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid accepted denom: %s", err)
+		}
+		if seen[denom] {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate accepted denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	id := k.nextCampaignID(ctx)
+	campaign := Campaign{
+		ID:          id,
+		Creator:     creator,
+		Title:       title,
+		Beneficiary: beneficiary,
+		Goal:        goal,
+		Raised:      sdk.NewCoins(),
+		Deadline:    deadline,
+
+		AcceptedDenoms: acceptedDenoms,
+	}
+
+	k.SetCampaign(ctx, campaign)
+	k.setCampaignDeadline(ctx, campaign)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignCreated{
+		CampaignID:  id,
+		Creator:     creator,
+		Beneficiary: beneficiary,
+		Goal:        goal,
+		Deadline:    deadline,
+
+		AcceptedDenoms: acceptedDenoms,
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// DonateToCampaign processes a donation directed to a campaign. With an
+// escrow period set, the donation is held in escrow instead and credited to
+// the campaign on release.
+func (k Keeper) DonateToCampaign(
+	ctx sdk.Context,
+	donor string,
+	campaignID uint64,
+	amount sdk.Coins,
+	memo string,
+	anonymous bool,
+) error {
+	if k.escrowEnabled(ctx) {
+		_, err := k.EscrowDonation(ctx, donor, campaignID, amount, memo, anonymous)
+		return err
+	}
+
+	_, err := k.donateToCampaign(ctx, donor, campaignID, amount, memo, anonymous)
+	return err
+}
+
+// donateToCampaign processes a campaign donation immediately, bypassing any
+// escrow period, and returns the recorded donation
+func (k Keeper) donateToCampaign(
+	ctx sdk.Context,
+	donor string,
+	campaignID uint64,
+	amount sdk.Coins,
+	memo string,
+	anonymous bool,
+) (Donation, error) {
+	if err := k.checkCampaignDonation(ctx, campaignID, amount); err != nil {
+		return Donation{}, err
+	}
+
+	donation, err := k.donate(ctx, donor, amount, memo, anonymous)
+	if err != nil {
+		return Donation{}, err
+	}
+
+	return donation, k.creditCampaign(ctx, donation, campaignID)
+}
+
+// checkCampaignDonation rejects a donation the campaign can't accept now
+func (k Keeper) checkCampaignDonation(ctx sdk.Context, campaignID uint64, amount sdk.Coins) error {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	if campaign.Archived {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d is archived", campaignID)
+	}
+
+	if campaign.Status != CampaignActive {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has been finalized", campaignID)
+	}
+
+	if campaign.Deadline > 0 && ctx.BlockTime().Unix() >= campaign.Deadline {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has ended", campaignID)
+	}
+
+	return k.checkCampaignDenoms(ctx, campaign, amount)
+}
+
+// creditCampaign adds a recorded donation to the campaign's raised total
+// and the donor's refundable contribution, and notes the campaign on the
+// donation record so a refund can reverse it
+func (k Keeper) creditCampaign(ctx sdk.Context, donation Donation, campaignID uint64) error {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	campaign.Raised = campaign.Raised.Add(donation.Amount...)
+	k.SetCampaign(ctx, campaign)
+	if teamID := k.creditTeam(ctx, donation.Donor, campaignID, donation.Amount); teamID != 0 {
+		donation.TeamID = teamID
+	}
+	k.addCampaignContribution(ctx, donation.Donor, campaignID, donation.Amount, donation.USD)
+
+	donation.CampaignID = campaignID
+	k.setDonation(ctx, donation)
+
+	donorRecord, _ := k.GetDonor(ctx, donation.Donor)
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignDonation{
+		CampaignID: campaignID,
+		Donor:      publicDonor(donorRecord),
+		Amount:     donation.Amount,
+		Raised:     campaign.Raised,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkCampaignDenoms rejects coins the campaign does not accept
+func (k Keeper) checkCampaignDenoms(ctx sdk.Context, campaign Campaign, amount sdk.Coins) error {
+	if len(campaign.AcceptedDenoms) == 0 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	for _, coin := range amount {
+		var baseDenom string
+		if isIBCDenom(coin.Denom) {
+			baseDenom, _ = k.resolveIBCDenom(ctx, params, coin.Denom)
+		}
+		if !campaign.AcceptsDenom(coin.Denom, baseDenom) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"campaign %d does not accept %s; accepted denoms: %s",
+				campaign.ID, coin.Denom, strings.Join(campaign.AcceptedDenoms, ", "),
+			)
+		}
+	}
+
+	return nil
+}
+
+// ArchiveCampaign freezes a finished campaign. Archived campaigns accept no
+// further donations and are dropped from active listings; their records
+// are retained.
+func (k Keeper) ArchiveCampaign(ctx sdk.Context, sender string, campaignID uint64) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	if sender != campaign.Creator && sender != state.Admin {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only creator or admin can archive")
+	}
+
+	if campaign.Archived {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "already archived")
+	}
+
+	if !campaign.IsFinished(ctx.BlockTime().Unix()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "campaign is still running")
+	}
+
+	campaign.Archived = true
+	k.SetCampaign(ctx, campaign)
+
+	if err := k.recordAudit(ctx, sender, AuditArchiveCampaign, map[string]interface{}{
+		"campaign_id": campaignID,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignArchived{
+		CampaignID: campaignID,
+		Sender:     sender,
+		Raised:     campaign.Raised,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnarchiveCampaign reopens an archived campaign; governance only
+func (k Keeper) UnarchiveCampaign(ctx sdk.Context, authority string, campaignID uint64) error {
+	if authority != k.authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	if !campaign.Archived {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not archived")
+	}
+
+	campaign.Archived = false
+	k.SetCampaign(ctx, campaign)
+
+	if err := k.recordAudit(ctx, authority, AuditUnarchiveCampaign, map[string]interface{}{
+		"campaign_id": campaignID,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignUnarchived{
+		CampaignID: campaignID,
+		Authority:  authority,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCampaign retrieves a campaign
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (Campaign, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCampaignKey(id))
+	if bz == nil {
+		return Campaign{}, false
+	}
+
+	var campaign Campaign
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+// SetCampaign stores a campaign and keeps the active index in sync
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign Campaign) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&campaign)
+	store.Set(GetCampaignKey(campaign.ID), bz)
+
+	if campaign.Archived {
+		store.Delete(GetActiveCampaignKey(campaign.ID))
+	} else {
+		store.Set(GetActiveCampaignKey(campaign.ID), []byte{})
+	}
+}
+
+// GetActiveCampaigns returns all campaigns that are not archived
+func (k Keeper) GetActiveCampaigns(ctx sdk.Context) []Campaign {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ActiveCampaignKeyPrefix)
+	defer iterator.Close()
+
+	campaigns := []Campaign{}
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(ActiveCampaignKeyPrefix):])
+		if campaign, found := k.GetCampaign(ctx, id); found {
+			campaigns = append(campaigns, campaign)
+		}
+	}
+
+	return campaigns
+}
+
+// GetAllCampaigns returns all campaigns, including archived ones
+func (k Keeper) GetAllCampaigns(ctx sdk.Context) []Campaign {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, CampaignKeyPrefix)
+	defer iterator.Close()
+
+	campaigns := []Campaign{}
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign Campaign
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns
+}
+
+// nextCampaignID returns the next campaign ID and advances the sequence
+func (k Keeper) nextCampaignID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(CampaignSeqKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(CampaignSeqKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
@@ -0,0 +1,168 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ExtensionPolicy extends a campaign's deadline by ExtendBy seconds when it
+// is at least Threshold funded at expiry, at most MaxExtensions times,
+// e.g. "extend by 7 days if 80% funded, up to twice"
+type ExtensionPolicy struct {
+	Threshold     sdk.Dec // funded fraction of the goal, in (0, 1]
+	ExtendBy      int64   // seconds
+	MaxExtensions uint32
+}
+
+// IsSet reports whether the policy is enabled
+func (p ExtensionPolicy) IsSet() bool {
+	return p.MaxExtensions > 0
+}
+
+// Validate performs basic validation of the policy
+func (p ExtensionPolicy) Validate() error {
+	if !p.IsSet() {
+		return nil
+	}
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() || p.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("extension threshold must be in (0, 1]")
+	}
+	if p.ExtendBy <= 0 {
+		return fmt.Errorf("extension period must be positive")
+	}
+	return nil
+}
+
+// FundedFraction returns how much of the goal is raised, taking the least
+// funded denom of a multi-denom goal
+func (c Campaign) FundedFraction() sdk.Dec {
+	if c.Goal.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	funded := sdk.OneDec()
+	for _, goal := range c.Goal {
+		fraction := sdk.NewDecFromInt(c.Raised.AmountOf(goal.Denom)).QuoInt(goal.Amount)
+		if fraction.LT(funded) {
+			funded = fraction
+		}
+	}
+	return funded
+}
+
+// GetCampaignExtensionKey returns the key of a campaign in the extension
+// queue, ordered by deadline
+func GetCampaignExtensionKey(deadline int64, id uint64) []byte {
+	key := append(CampaignExtensionQueuePrefix, sdk.Uint64ToBigEndian(uint64(deadline))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SetCampaignExtensionPolicy sets a campaign's extension policy; an unset
+// policy removes it. Only the creator or governance (the module authority)
+// may set it, before the campaign ends, and only on campaigns with a goal
+// and a deadline.
+func (k Keeper) SetCampaignExtensionPolicy(ctx sdk.Context, sender string, campaignID uint64, policy ExtensionPolicy) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	if sender != campaign.Creator && sender != k.authority {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only creator or governance can set the extension policy")
+	}
+
+	if campaign.Archived || campaign.IsFinished(ctx.BlockTime().Unix()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has ended", campaignID)
+	}
+
+	if policy.IsSet() && (campaign.Goal.IsZero() || campaign.Deadline == 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extension needs a campaign with a goal and a deadline")
+	}
+
+	if err := policy.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if policy.IsSet() && campaign.Extensions < policy.MaxExtensions {
+		store.Set(GetCampaignExtensionKey(campaign.Deadline, campaign.ID), []byte{})
+	} else {
+		store.Delete(GetCampaignExtensionKey(campaign.Deadline, campaign.ID))
+	}
+
+	campaign.ExtensionPolicy = policy
+	k.SetCampaign(ctx, campaign)
+
+	return nil
+}
+
+// extendCampaigns evaluates the extension policy of every queued campaign
+// whose deadline has passed. Campaigns funded to the threshold but short of
+// the goal get a new deadline; the rest leave the queue and end.
+func (k Keeper) extendCampaigns(ctx sdk.Context) error {
+	now := ctx.BlockTime().Unix()
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the due entries first; the loop below rewrites the queue
+	iterator := sdk.KVStorePrefixIterator(store, CampaignExtensionQueuePrefix)
+	var due [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		deadline := int64(sdk.BigEndianToUint64(key[len(CampaignExtensionQueuePrefix) : len(CampaignExtensionQueuePrefix)+8]))
+		if deadline > now {
+			break
+		}
+		due = append(due, append([]byte{}, key...))
+	}
+	iterator.Close()
+
+	for _, key := range due {
+		store.Delete(key)
+
+		id := sdk.BigEndianToUint64(key[len(CampaignExtensionQueuePrefix)+8:])
+		campaign, found := k.GetCampaign(ctx, id)
+		if !found || campaign.Archived {
+			continue
+		}
+
+		policy := campaign.ExtensionPolicy
+		if !policy.IsSet() || campaign.Extensions >= policy.MaxExtensions {
+			continue
+		}
+
+		funded := campaign.FundedFraction()
+		if funded.LT(policy.Threshold) || campaign.Raised.IsAllGTE(campaign.Goal) {
+			continue
+		}
+
+		previous := campaign.Deadline
+		campaign.Deadline += policy.ExtendBy
+		campaign.Extensions++
+		k.SetCampaign(ctx, campaign)
+		k.removeCampaignDeadline(ctx, previous, campaign.ID)
+		k.setCampaignDeadline(ctx, campaign)
+
+		if campaign.Extensions < policy.MaxExtensions {
+			store.Set(GetCampaignExtensionKey(campaign.Deadline, campaign.ID), []byte{})
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&EventCampaignExtended{
+			CampaignID:       campaign.ID,
+			PreviousDeadline: previous,
+			Deadline:         campaign.Deadline,
+			Extension:        campaign.Extensions,
+			FundedFraction:   funded,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
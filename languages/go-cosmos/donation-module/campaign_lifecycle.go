@@ -0,0 +1,316 @@
+package donation
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CampaignStatus is the lifecycle state of a campaign
+type CampaignStatus uint8
+
+const (
+	CampaignActive CampaignStatus = iota
+	CampaignSucceeded
+	CampaignFailed
+)
+
+// CampaignContribution is what a donor has given to a campaign since
+// contributions were tracked; it is refundable if the campaign fails
+type CampaignContribution struct {
+	CampaignID uint64
+	Donor      string
+	Amount     sdk.Coins
+	// USD is Amount's value when donated. Nil for contributions tracked
+	// before it was kept.
+	USD sdk.Dec
+}
+
+// setCampaignDeadline queues a campaign for finalization at its deadline
+func (k Keeper) setCampaignDeadline(ctx sdk.Context, campaign Campaign) {
+	if campaign.Deadline == 0 || campaign.Status != CampaignActive {
+		return
+	}
+	if err := k.campaignDeadlines.Set(ctx, collections.Join(uint64(campaign.Deadline), campaign.ID)); err != nil {
+		panic(err)
+	}
+}
+
+// removeCampaignDeadline drops a campaign's finalization queue entry for
+// deadline
+func (k Keeper) removeCampaignDeadline(ctx sdk.Context, deadline int64, id uint64) {
+	if err := k.campaignDeadlines.Remove(ctx, collections.Join(uint64(deadline), id)); err != nil {
+		panic(err)
+	}
+}
+
+// finalizeCampaigns closes the campaigns whose deadline has passed. Each
+// finalization is all-or-nothing; a failed one is retried next block.
+func (k Keeper) finalizeCampaigns(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(uint64(ctx.BlockTime().Unix())+1, uint64(0)))
+	due, err := k.campaignDeadlines.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		campaign, found := k.GetCampaign(ctx, key.K2())
+		if !found || campaign.Status != CampaignActive {
+			k.removeCampaignDeadline(ctx, int64(key.K1()), key.K2())
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.finalizeCampaign(cacheCtx, campaign); err != nil {
+			ctx.Logger().Error("failed to finalize campaign", "id", campaign.ID, "err", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// finalizeCampaign marks a campaign past its deadline successful if it met
+// its goal (any amount for a campaign without one) and failed otherwise.
+// A successful campaign's raised amount is scheduled for payout to the
+// beneficiary after Params.CampaignPayoutDelay blocks; a failed campaign's
+// donors can claim refunds of their contributions.
+func (k Keeper) finalizeCampaign(ctx sdk.Context, campaign Campaign) error {
+	k.removeCampaignDeadline(ctx, campaign.Deadline, campaign.ID)
+
+	if campaign.Goal.IsZero() || campaign.Raised.IsAllGTE(campaign.Goal) {
+		campaign.Status = CampaignSucceeded
+		if !campaign.Raised.IsZero() {
+			campaign.PayoutHeight = ctx.BlockHeight() + int64(k.GetParams(ctx).CampaignPayoutDelay)
+			if err := k.campaignPayoutQueue.Set(ctx, collections.Join(uint64(campaign.PayoutHeight), campaign.ID)); err != nil {
+				panic(err)
+			}
+		}
+	} else {
+		campaign.Status = CampaignFailed
+	}
+	k.SetCampaign(ctx, campaign)
+
+	return ctx.EventManager().EmitTypedEvent(&EventCampaignFinalized{
+		CampaignID:   campaign.ID,
+		Succeeded:    campaign.Status == CampaignSucceeded,
+		Goal:         campaign.Goal,
+		Raised:       campaign.Raised,
+		PayoutHeight: campaign.PayoutHeight,
+	})
+}
+
+// payCampaigns pays out the successful campaigns whose payout height has
+// been reached. A failed payout, e.g. when the raised funds have been
+// withdrawn, is retried next block.
+func (k Keeper) payCampaigns(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(uint64(ctx.BlockHeight())+1, uint64(0)))
+	due, err := k.campaignPayoutQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		campaign, found := k.GetCampaign(ctx, key.K2())
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d", key.K2())
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.payCampaign(cacheCtx, campaign); err != nil {
+			ctx.Logger().Error("failed to pay out campaign", "id", campaign.ID, "err", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// payCampaign sends a successful campaign's raised amount, including
+// escrowed donations released since it ended, to its beneficiary. The
+// payout counts as a withdrawal.
+func (k Keeper) payCampaign(ctx sdk.Context, campaign Campaign) error {
+	if err := k.campaignPayoutQueue.Remove(ctx, collections.Join(uint64(campaign.PayoutHeight), campaign.ID)); err != nil {
+		panic(err)
+	}
+
+	state, _ := k.GetState(ctx)
+	amount := campaign.Raised
+	if !state.Withdrawable().IsAllGTE(amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "payout of %s exceeds withdrawable balance", amount)
+	}
+
+	beneficiaryAddr, err := sdk.AccAddressFromBech32(campaign.Beneficiary)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, beneficiaryAddr, amount); err != nil {
+		return err
+	}
+
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(amount...)
+	k.SetState(ctx, state)
+	incrWithdrawalMetrics("campaign")
+
+	campaign.PaidOut = amount
+	k.SetCampaign(ctx, campaign)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignPaidOut{
+		CampaignID:  campaign.ID,
+		Beneficiary: campaign.Beneficiary,
+		Amount:      amount,
+	}); err != nil {
+		return err
+	}
+
+	return k.afterWithdrawal(ctx, campaign.Beneficiary, amount)
+}
+
+// addCampaignContribution records a donation to a campaign for refunds
+func (k Keeper) addCampaignContribution(ctx sdk.Context, donor string, campaignID uint64, amount sdk.Coins, usd sdk.Dec) {
+	contribution, found := k.GetCampaignContribution(ctx, campaignID, donor)
+	if found && contribution.USD.IsNil() {
+		// Value the untracked part at today's price once, so the sum stays
+		// usable for refunds
+		contribution.USD = k.usdValue(ctx, contribution.Amount)
+	}
+	contribution.CampaignID = campaignID
+	contribution.Donor = donor
+	contribution.Amount = contribution.Amount.Add(amount...)
+	contribution.USD = addUSD(contribution.USD, usd)
+	if err := k.campaignContributions.Set(ctx, collections.Join(campaignID, donor), contribution); err != nil {
+		panic(err)
+	}
+}
+
+// subCampaignContribution takes a refunded donation off the donor's
+// contribution to a campaign, removing the contribution once it is empty
+func (k Keeper) subCampaignContribution(ctx sdk.Context, donor string, campaignID uint64, amount sdk.Coins, usd sdk.Dec) {
+	contribution, found := k.GetCampaignContribution(ctx, campaignID, donor)
+	if !found {
+		return
+	}
+
+	key := collections.Join(campaignID, donor)
+	remaining, _ := contribution.Amount.SafeSub(amount...)
+	contribution.Amount = positiveCoins(remaining)
+	if contribution.Amount.IsZero() {
+		if err := k.campaignContributions.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if !contribution.USD.IsNil() {
+		contribution.USD = addUSD(contribution.USD, usd.Neg())
+	}
+	if err := k.campaignContributions.Set(ctx, key, contribution); err != nil {
+		panic(err)
+	}
+}
+
+// ClaimCampaignRefund returns a donor's contribution to a failed campaign.
+// The refund is taken off the donor's record like an admin refund.
+func (k Keeper) ClaimCampaignRefund(ctx sdk.Context, donor string, campaignID uint64) (sdk.Coins, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	if campaign.Status != CampaignFailed {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has not failed", campaignID)
+	}
+
+	contribution, found := k.GetCampaignContribution(ctx, campaignID, donor)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no refundable contribution to campaign %d", campaignID)
+	}
+
+	donorRecord, found := k.GetDonor(ctx, donor)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "donor not found")
+	}
+
+	amount := contribution.Amount
+	if !donorRecord.TotalDonated.IsAllGTE(amount) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "refund exceeds donated amount")
+	}
+
+	// Funds already withdrawn cannot be refunded
+	if !state.Withdrawable().IsAllGTE(amount) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "refund exceeds withdrawable balance")
+	}
+
+	donorAddr, err := sdk.AccAddressFromBech32(donor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, donorAddr, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.campaignContributions.Remove(ctx, collections.Join(campaignID, donor)); err != nil {
+		panic(err)
+	}
+
+	usd := contribution.USD
+	if usd.IsNil() {
+		usd = k.usdValue(ctx, amount)
+	}
+	previousTier := k.debitDonor(ctx, &state, &donorRecord, amount, usd)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignRefundClaimed{
+		CampaignID: campaignID,
+		Donor:      publicDonor(donorRecord),
+		Amount:     amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.afterTierChange(ctx, donor, previousTier, donorRecord.Tier); err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
+
+// GetCampaignContribution returns a donor's refundable contribution to a
+// campaign
+func (k Keeper) GetCampaignContribution(ctx sdk.Context, campaignID uint64, donor string) (CampaignContribution, bool) {
+	contribution, err := k.campaignContributions.Get(ctx, collections.Join(campaignID, donor))
+	if errors.Is(err, collections.ErrNotFound) {
+		return CampaignContribution{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return contribution, true
+}
+
+// indexCampaignDeadlines queues every running campaign with a deadline for
+// finalization
+func (k Keeper) indexCampaignDeadlines(ctx sdk.Context) {
+	for _, campaign := range k.GetAllCampaigns(ctx) {
+		k.setCampaignDeadline(ctx, campaign)
+	}
+}
@@ -0,0 +1,153 @@
+package donation
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// MaxCampaignUpdateTitleLength bounds the title of a campaign update
+const MaxCampaignUpdateTitleLength = 140
+
+// CampaignUpdate is a post in a campaign's append-only update feed. The
+// content itself lives off-chain at URI; ContentHash is its sha256 so
+// supporters can check that what a frontend shows is what was posted.
+type CampaignUpdate struct {
+	CampaignID  uint64
+	Index       uint64
+	Author      string
+	Title       string
+	URI         string
+	ContentHash []byte
+	Height      int64
+	Time        int64 // unix seconds
+}
+
+// GetCampaignUpdatesPrefix returns the prefix of a campaign's update feed
+func GetCampaignUpdatesPrefix(campaignID uint64) []byte {
+	return append(CampaignUpdateKeyPrefix, sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetCampaignUpdateKey returns the store key of a campaign update
+func GetCampaignUpdateKey(campaignID, index uint64) []byte {
+	return append(GetCampaignUpdatesPrefix(campaignID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// PostCampaignUpdate appends an update to a campaign's feed. Only the
+// campaign creator or the admin may post; updates cannot be edited or
+// removed, archived campaigns included.
+func (k Keeper) PostCampaignUpdate(
+	ctx sdk.Context,
+	author string,
+	campaignID uint64,
+	title string,
+	uri string,
+	contentHash []byte,
+) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+
+	if author != campaign.Creator && author != state.Admin {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only creator or admin can post updates")
+	}
+
+	if title == "" || len(title) > MaxCampaignUpdateTitleLength {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "title must be 1-%d bytes", MaxCampaignUpdateTitleLength)
+	}
+
+	if uri == "" {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "update URI required")
+	}
+
+	if len(contentHash) != sha256.Size {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "content hash must be %d bytes", sha256.Size)
+	}
+
+	update := CampaignUpdate{
+		CampaignID:  campaignID,
+		Index:       k.nextCampaignUpdateIndex(ctx, campaignID),
+		Author:      author,
+		Title:       title,
+		URI:         uri,
+		ContentHash: contentHash,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime().Unix(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&update)
+	store.Set(GetCampaignUpdateKey(campaignID, update.Index), bz)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventCampaignUpdatePosted{
+		CampaignID:  campaignID,
+		Index:       update.Index,
+		Author:      author,
+		URI:         uri,
+		ContentHash: contentHash,
+	}); err != nil {
+		return 0, err
+	}
+
+	return update.Index, nil
+}
+
+// GetCampaignUpdate retrieves a single campaign update
+func (k Keeper) GetCampaignUpdate(ctx sdk.Context, campaignID, index uint64) (CampaignUpdate, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCampaignUpdateKey(campaignID, index))
+	if bz == nil {
+		return CampaignUpdate{}, false
+	}
+
+	var update CampaignUpdate
+	k.cdc.MustUnmarshal(bz, &update)
+	return update, true
+}
+
+// QueryCampaignUpdates returns a page of a campaign's updates, oldest first
+// (set Reverse in the page request for newest first)
+func (k Keeper) QueryCampaignUpdates(
+	ctx sdk.Context,
+	campaignID uint64,
+	pageReq *query.PageRequest,
+) ([]CampaignUpdate, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetCampaignUpdatesPrefix(campaignID))
+
+	updates := []CampaignUpdate{}
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var update CampaignUpdate
+		if err := k.cdc.Unmarshal(value, &update); err != nil {
+			return err
+		}
+		updates = append(updates, update)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return updates, pageRes, nil
+}
+
+// nextCampaignUpdateIndex returns the index following the campaign's last
+// update, starting at 1
+func (k Keeper) nextCampaignUpdateIndex(ctx sdk.Context, campaignID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetCampaignUpdatesPrefix(campaignID))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+	return sdk.BigEndianToUint64(iterator.Key()) + 1
+}
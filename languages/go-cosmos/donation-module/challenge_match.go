@@ -0,0 +1,250 @@
+package donation
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ChallengeStatus is the lifecycle state of a challenge match
+type ChallengeStatus uint8
+
+const (
+	ChallengePending ChallengeStatus = iota
+	ChallengeTriggered
+	ChallengeExpired
+)
+
+// ChallengeMatch is a sponsor's pledge for a pledge drive: if the campaign
+// raises Target before Deadline, the escrowed Match is donated to it;
+// otherwise it is refunded to the sponsor
+type ChallengeMatch struct {
+	ID         uint64
+	CampaignID uint64
+	Sponsor    string
+	Target     sdk.Coin  // raised in Target.Denom that triggers the match
+	Deadline   int64     // unix seconds
+	Match      sdk.Coins // escrowed in the module account until resolved
+	Status     ChallengeStatus
+}
+
+// GetChallengeMatchKey returns the store key of a challenge match
+func GetChallengeMatchKey(id uint64) []byte {
+	return append(ChallengeMatchKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingChallengeKey returns the key of a challenge awaiting evaluation
+func GetPendingChallengeKey(id uint64) []byte {
+	return append(PendingChallengeKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// CreateChallengeMatch escrows match from the sponsor and pledges it to a
+// running campaign, to be donated once the campaign raises target before
+// deadline. The EndBlocker evaluates pending challenges every block.
+func (k Keeper) CreateChallengeMatch(
+	ctx sdk.Context,
+	sponsor string,
+	campaignID uint64,
+	target sdk.Coin,
+	deadline int64,
+	match sdk.Coins,
+) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !target.IsValid() || !target.IsPositive() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "challenge target must be positive")
+	}
+
+	if !match.IsValid() || match.IsZero() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid challenge match amount")
+	}
+
+	if deadline <= ctx.BlockTime().Unix() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "challenge deadline must be in the future")
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+	}
+	if campaign.Archived || campaign.IsFinished(ctx.BlockTime().Unix()) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has ended", campaignID)
+	}
+	if campaign.Raised.AmountOf(target.Denom).GTE(target.Amount) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d already raised %s", campaignID, target)
+	}
+
+	if err := k.checkCampaignDenoms(ctx, campaign, match); err != nil {
+		return 0, err
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, ModuleName, match); err != nil {
+		return 0, err
+	}
+
+	pool := k.GetMatchingPool(ctx)
+	pool.Escrowed = pool.Escrowed.Add(match...)
+	k.SetMatchingPool(ctx, pool)
+
+	challenge := ChallengeMatch{
+		ID:         k.nextChallengeMatchID(ctx),
+		CampaignID: campaignID,
+		Sponsor:    sponsor,
+		Target:     target,
+		Deadline:   deadline,
+		Match:      match,
+		Status:     ChallengePending,
+	}
+	k.setChallengeMatch(ctx, challenge)
+	ctx.KVStore(k.storeKey).Set(GetPendingChallengeKey(challenge.ID), []byte{})
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventChallengeMatchCreated{
+		ID:         challenge.ID,
+		CampaignID: campaignID,
+		Sponsor:    sponsor,
+		Target:     target,
+		Deadline:   deadline,
+		Match:      match,
+	}); err != nil {
+		return 0, err
+	}
+
+	return challenge.ID, nil
+}
+
+// GetChallengeMatch returns a challenge match by ID
+func (k Keeper) GetChallengeMatch(ctx sdk.Context, id uint64) (ChallengeMatch, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetChallengeMatchKey(id))
+	if bz == nil {
+		return ChallengeMatch{}, false
+	}
+
+	var challenge ChallengeMatch
+	k.cdc.MustUnmarshal(bz, &challenge)
+	return challenge, true
+}
+
+func (k Keeper) setChallengeMatch(ctx sdk.Context, challenge ChallengeMatch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetChallengeMatchKey(challenge.ID), k.cdc.MustMarshal(&challenge))
+}
+
+// QueryCampaignChallenges returns every challenge match pledged to a
+// campaign, resolved ones included
+func (k Keeper) QueryCampaignChallenges(ctx sdk.Context, campaignID uint64) []ChallengeMatch {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ChallengeMatchKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	challenges := []ChallengeMatch{}
+	for ; iterator.Valid(); iterator.Next() {
+		var challenge ChallengeMatch
+		k.cdc.MustUnmarshal(iterator.Value(), &challenge)
+		if challenge.CampaignID == campaignID {
+			challenges = append(challenges, challenge)
+		}
+	}
+
+	return challenges
+}
+
+// evaluateChallenges triggers every pending challenge whose campaign has
+// reached its target and refunds those past their deadline. A match is
+// donated to the campaign on the sponsor's behalf, all-or-nothing; if the
+// donation is rejected it is retried next block until the deadline.
+func (k Keeper) evaluateChallenges(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PendingChallengeKeyPrefix)
+	var pending []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		pending = append(pending, sdk.BigEndianToUint64(iterator.Key()[len(PendingChallengeKeyPrefix):]))
+	}
+	iterator.Close()
+
+	for _, id := range pending {
+		challenge, found := k.GetChallengeMatch(ctx, id)
+		if !found {
+			store.Delete(GetPendingChallengeKey(id))
+			continue
+		}
+
+		campaign, _ := k.GetCampaign(ctx, challenge.CampaignID)
+		if campaign.Raised.AmountOf(challenge.Target.Denom).GTE(challenge.Target.Amount) {
+			cacheCtx, write := ctx.CacheContext()
+			err := k.resolveChallenge(cacheCtx, challenge, ChallengeTriggered)
+			if err == nil {
+				write()
+				continue
+			}
+			ctx.Logger().Error("failed to trigger challenge match", "id", id, "err", err)
+		}
+
+		if now >= challenge.Deadline {
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.resolveChallenge(cacheCtx, challenge, ChallengeExpired); err != nil {
+				ctx.Logger().Error("failed to refund challenge match", "id", id, "err", err)
+				continue
+			}
+			write()
+		}
+	}
+}
+
+// resolveChallenge releases a challenge's escrow: a triggered match is
+// recorded as the sponsor's donation to the campaign, the coins already
+// being in the module account; an expired one is refunded to the sponsor
+func (k Keeper) resolveChallenge(ctx sdk.Context, challenge ChallengeMatch, status ChallengeStatus) error {
+	pool := k.GetMatchingPool(ctx)
+	pool.Escrowed = pool.Escrowed.Sub(challenge.Match...)
+	k.SetMatchingPool(ctx, pool)
+
+	if status == ChallengeTriggered {
+		// Matches are pledged in advance, so they skip the escrow period
+		if _, err := k.donateToCampaign(ctx, challenge.Sponsor, challenge.CampaignID, challenge.Match, "", false); err != nil {
+			return err
+		}
+	} else {
+		sponsorAddr, err := sdk.AccAddressFromBech32(challenge.Sponsor)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, sponsorAddr, challenge.Match); err != nil {
+			return err
+		}
+	}
+
+	challenge.Status = status
+	k.setChallengeMatch(ctx, challenge)
+	ctx.KVStore(k.storeKey).Delete(GetPendingChallengeKey(challenge.ID))
+
+	return ctx.EventManager().EmitTypedEvent(&EventChallengeMatchResolved{
+		ID:         challenge.ID,
+		CampaignID: challenge.CampaignID,
+		Sponsor:    challenge.Sponsor,
+		Match:      challenge.Match,
+		Triggered:  status == ChallengeTriggered,
+	})
+}
+
+// nextChallengeMatchID returns the next challenge match ID and advances the
+// sequence
+func (k Keeper) nextChallengeMatchID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(ChallengeMatchSeqKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(ChallengeMatchSeqKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
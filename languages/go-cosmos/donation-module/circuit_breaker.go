@@ -0,0 +1,132 @@
+package donation
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msgTypeURLPrefix prefixes the type URL of every donation Msg; the Msg
+// service method X takes /donation.v1.MsgX
+const msgTypeURLPrefix = "/donation.v1.Msg"
+
+// SetCircuitBreaker disables or re-enables individual donation Msgs, e.g.
+// only withdrawals, leaving the rest of the module running. Unlike Pause it
+// applies to any Msg, and a disabled MsgDonate also stops IBC and CosmWasm
+// donations. MsgSetCircuitBreaker itself can't be disabled. Governance or
+// ADMIN only.
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, sender string, typeURLs []string, disabled bool) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if sender != k.authority && !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance or ADMIN can trip the circuit breaker")
+	}
+
+	if err := validateCircuitTypeURLs(typeURLs); err != nil {
+		return err
+	}
+
+	for _, typeURL := range typeURLs {
+		var err error
+		if disabled {
+			err = k.disabledMsgs.Set(ctx, typeURL)
+		} else {
+			err = k.disabledMsgs.Remove(ctx, typeURL)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.recordAudit(ctx, sender, AuditCircuitBreaker, map[string]interface{}{
+		"type_urls": typeURLs,
+		"disabled":  disabled,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventCircuitBreakerUpdated{
+		Sender:   sender,
+		TypeURLs: typeURLs,
+		Disabled: disabled,
+	})
+}
+
+// IsMsgDisabled reports whether the circuit breaker has disabled the Msg
+// with the given type URL
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, typeURL string) bool {
+	disabled, err := k.disabledMsgs.Has(ctx, typeURL)
+	if err != nil {
+		panic(err)
+	}
+	return disabled
+}
+
+// checkCircuit fails if the Msg with the given type URL is disabled
+func (k Keeper) checkCircuit(ctx context.Context, typeURL string) error {
+	if k.IsMsgDisabled(sdk.UnwrapSDKContext(ctx), typeURL) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is disabled by the circuit breaker", typeURL)
+	}
+	return nil
+}
+
+// QueryDisabledMsgs returns the type URLs of the disabled Msgs, sorted
+func (k Keeper) QueryDisabledMsgs(ctx sdk.Context) []string {
+	iterator, err := k.disabledMsgs.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	typeURLs, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+	if typeURLs == nil {
+		typeURLs = []string{}
+	}
+	return typeURLs
+}
+
+// validateCircuitTypeURLs checks that every type URL names a donation Msg
+// the circuit breaker may disable
+func validateCircuitTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no Msg type URLs")
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate type URL %s", typeURL)
+		}
+		seen[typeURL] = true
+
+		if !isDonationMsgTypeURL(typeURL) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a donation Msg", typeURL)
+		}
+		if typeURL == msgTypeURLPrefix+"SetCircuitBreaker" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the circuit breaker Msg can't be disabled")
+		}
+	}
+	return nil
+}
+
+// isDonationMsgTypeURL reports whether typeURL is a Msg of the donation Msg
+// service
+func isDonationMsgTypeURL(typeURL string) bool {
+	method, ok := strings.CutPrefix(typeURL, msgTypeURLPrefix)
+	if !ok {
+		return false
+	}
+	for _, m := range msgServiceDesc.Methods {
+		if m.MethodName == method {
+			return true
+		}
+	}
+	return false
+}
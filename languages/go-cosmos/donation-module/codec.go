@@ -0,0 +1,128 @@
+package donation
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// RegisterLegacyAminoCodec registers the module's Msgs for amino JSON signing
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgDonate{}, "donation/MsgDonate", nil)
+	cdc.RegisterConcrete(&MsgBatchDonate{}, "donation/MsgBatchDonate", nil)
+	cdc.RegisterConcrete(&MsgWithdraw{}, "donation/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgDistribute{}, "donation/MsgDistribute", nil)
+	cdc.RegisterConcrete(&MsgSetBeneficiaries{}, "donation/MsgSetBeneficiaries", nil)
+	cdc.RegisterConcrete(&MsgRegisterOrganization{}, "donation/MsgRegisterOrganization", nil)
+	cdc.RegisterConcrete(&MsgSetOrganizationStatus{}, "donation/MsgSetOrganizationStatus", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "donation/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "donation/MsgUnpause", nil)
+	cdc.RegisterConcrete(&MsgUpdateDonorList{}, "donation/MsgUpdateDonorList", nil)
+	cdc.RegisterConcrete(&MsgSetAllowlistMode{}, "donation/MsgSetAllowlistMode", nil)
+	cdc.RegisterConcrete(&MsgSweepDust{}, "donation/MsgSweepDust", nil)
+	cdc.RegisterConcrete(&MsgDelegateFunds{}, "donation/MsgDelegateFunds", nil)
+	cdc.RegisterConcrete(&MsgUndelegateFunds{}, "donation/MsgUndelegateFunds", nil)
+	cdc.RegisterConcrete(&MsgClaimStakingRewards{}, "donation/MsgClaimStakingRewards", nil)
+	cdc.RegisterConcrete(&MsgBoostCampaign{}, "donation/MsgBoostCampaign", nil)
+	cdc.RegisterConcrete(&MsgSetVestingSchedule{}, "donation/MsgSetVestingSchedule", nil)
+	cdc.RegisterConcrete(&MsgCreateChallengeMatch{}, "donation/MsgCreateChallengeMatch", nil)
+	cdc.RegisterConcrete(&MsgUpdateDonationLimits{}, "donation/MsgUpdateDonationLimits", nil)
+	cdc.RegisterConcrete(&MsgSetCircuitBreaker{}, "donation/MsgSetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgSnapshotDonors{}, "donation/MsgSnapshotDonors", nil)
+	cdc.RegisterConcrete(&MsgFundRewardPool{}, "donation/MsgFundRewardPool", nil)
+	cdc.RegisterConcrete(&MsgDistributeRewards{}, "donation/MsgDistributeRewards", nil)
+	cdc.RegisterConcrete(&MsgClaimReward{}, "donation/MsgClaimReward", nil)
+	cdc.RegisterConcrete(&MsgRegisterRemoteDonationAccount{}, "donation/MsgRegisterRemoteDonationAccount", nil)
+	cdc.RegisterConcrete(&MsgForwardDonation{}, "donation/MsgForwardDonation", nil)
+	cdc.RegisterConcrete(&MsgRetryForwardDonation{}, "donation/MsgRetryForwardDonation", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "donation/MsgClawback", nil)
+	cdc.RegisterConcrete(&MsgClaimCampaignRefund{}, "donation/MsgClaimCampaignRefund", nil)
+	cdc.RegisterConcrete(&MsgMatchDonations{}, "donation/MsgMatchDonations", nil)
+	cdc.RegisterConcrete(&MsgRefund{}, "donation/MsgRefund", nil)
+	cdc.RegisterConcrete(&MsgArchiveCampaign{}, "donation/MsgArchiveCampaign", nil)
+	cdc.RegisterConcrete(&MsgUnarchiveCampaign{}, "donation/MsgUnarchiveCampaign", nil)
+	cdc.RegisterConcrete(&MsgTransferAdmin{}, "donation/MsgTransferAdmin", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, "donation/MsgAcceptAdmin", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "donation/MsgGrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "donation/MsgRevokeRole", nil)
+	cdc.RegisterConcrete(&MsgScheduleEmergencyWithdraw{}, "donation/MsgScheduleEmergencyWithdraw", nil)
+	cdc.RegisterConcrete(&MsgEmergencyWithdraw{}, "donation/MsgEmergencyWithdraw", nil)
+	cdc.RegisterConcrete(&MsgCancelEmergencyWithdraw{}, "donation/MsgCancelEmergencyWithdraw", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "donation/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgCancelParamsChange{}, "donation/MsgCancelParamsChange", nil)
+	cdc.RegisterConcrete(&MsgCreateCampaign{}, "donation/MsgCreateCampaign", nil)
+	cdc.RegisterConcrete(&MsgCreateTeam{}, "donation/MsgCreateTeam", nil)
+	cdc.RegisterConcrete(&MsgJoinTeam{}, "donation/MsgJoinTeam", nil)
+	cdc.RegisterConcrete(&MsgLeaveTeam{}, "donation/MsgLeaveTeam", nil)
+	cdc.RegisterConcrete(&MsgFundMatchingPool{}, "donation/MsgFundMatchingPool", nil)
+	cdc.RegisterConcrete(&MsgConfigureMatchingPool{}, "donation/MsgConfigureMatchingPool", nil)
+	cdc.RegisterConcrete(&MsgPostCampaignUpdate{}, "donation/MsgPostCampaignUpdate", nil)
+	cdc.RegisterConcrete(&MsgSetCampaignExtensionPolicy{}, "donation/MsgSetCampaignExtensionPolicy", nil)
+	cdc.RegisterConcrete(&MsgRecordIdentityAttestation{}, "donation/MsgRecordIdentityAttestation", nil)
+	cdc.RegisterConcrete(&DonateAuthorization{}, "donation/DonateAuthorization", nil)
+}
+
+// RegisterInterfaces registers the module's Msgs with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDonate{},
+		&MsgBatchDonate{},
+		&MsgWithdraw{},
+		&MsgDistribute{},
+		&MsgSetBeneficiaries{},
+		&MsgRegisterOrganization{},
+		&MsgSetOrganizationStatus{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgUpdateDonorList{},
+		&MsgSetAllowlistMode{},
+		&MsgSweepDust{},
+		&MsgDelegateFunds{},
+		&MsgUndelegateFunds{},
+		&MsgClaimStakingRewards{},
+		&MsgBoostCampaign{},
+		&MsgSetVestingSchedule{},
+		&MsgCreateChallengeMatch{},
+		&MsgUpdateDonationLimits{},
+		&MsgSetCircuitBreaker{},
+		&MsgSnapshotDonors{},
+		&MsgFundRewardPool{},
+		&MsgDistributeRewards{},
+		&MsgClaimReward{},
+		&MsgRegisterRemoteDonationAccount{},
+		&MsgForwardDonation{},
+		&MsgRetryForwardDonation{},
+		&MsgClawback{},
+		&MsgClaimCampaignRefund{},
+		&MsgMatchDonations{},
+		&MsgRefund{},
+		&MsgArchiveCampaign{},
+		&MsgUnarchiveCampaign{},
+		&MsgTransferAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgScheduleEmergencyWithdraw{},
+		&MsgEmergencyWithdraw{},
+		&MsgCancelEmergencyWithdraw{},
+		&MsgUpdateParams{},
+		&MsgCancelParamsChange{},
+		&MsgCreateCampaign{},
+		&MsgCreateTeam{},
+		&MsgJoinTeam{},
+		&MsgLeaveTeam{},
+		&MsgFundMatchingPool{},
+		&MsgConfigureMatchingPool{},
+		&MsgPostCampaignUpdate{},
+		&MsgSetCampaignExtensionPolicy{},
+		&MsgRecordIdentityAttestation{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&DonateAuthorization{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &msgServiceDesc)
+}
@@ -0,0 +1,193 @@
+package donation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	collcodec "cosmossdk.io/collections/codec"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// kvStoreService opens the module store for collections. SDK v0.47 has no
+// runtime KVStoreService, so the keeper adapts its store key itself.
+type kvStoreService struct {
+	key storetypes.StoreKey
+}
+
+var _ corestore.KVStoreService = kvStoreService{}
+
+// OpenKVStore implements corestore.KVStoreService
+func (s kvStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	return coreKVStore{sdk.UnwrapSDKContext(ctx).KVStore(s.key)}
+}
+
+// coreKVStore exposes an SDK KVStore through the error-returning core
+// interface; the SDK store panics instead of returning errors
+type coreKVStore struct {
+	store storetypes.KVStore
+}
+
+func (s coreKVStore) Get(key []byte) ([]byte, error) { return s.store.Get(key), nil }
+
+func (s coreKVStore) Has(key []byte) (bool, error) { return s.store.Has(key), nil }
+
+func (s coreKVStore) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+func (s coreKVStore) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
+
+func (s coreKVStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+func (s coreKVStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
+
+// protoValue encodes collection values with the keeper's codec, matching
+// what was written with cdc.MustMarshal before the move to collections
+type protoValue[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}] struct {
+	cdc codec.BinaryCodec
+}
+
+func newProtoValue[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}](cdc codec.BinaryCodec) collcodec.ValueCodec[T] {
+	return protoValue[T, PT]{cdc: cdc}
+}
+
+func (c protoValue[T, PT]) Encode(value T) ([]byte, error) {
+	return c.cdc.Marshal(PT(&value))
+}
+
+func (c protoValue[T, PT]) Decode(b []byte) (T, error) {
+	var value T
+	err := c.cdc.Unmarshal(b, PT(&value))
+	return value, err
+}
+
+func (c protoValue[T, PT]) EncodeJSON(value T) ([]byte, error) {
+	return codec.ProtoMarshalJSON(PT(&value), nil)
+}
+
+func (c protoValue[T, PT]) DecodeJSON(b []byte) (T, error) {
+	var value T
+	err := jsonpb.Unmarshal(bytes.NewReader(b), PT(&value))
+	return value, err
+}
+
+func (c protoValue[T, PT]) Stringify(value T) string {
+	return PT(&value).String()
+}
+
+func (c protoValue[T, PT]) ValueType() string {
+	var value T
+	return "gogoproto/" + proto.MessageName(PT(&value))
+}
+
+// donorAddressKey encodes a bech32 address as one length byte followed by
+// the address, in every position of a key. This is the donor key layout
+// since version 4, so the collections read existing state unchanged.
+type donorAddressKey struct{}
+
+var _ collcodec.KeyCodec[string] = donorAddressKey{}
+
+func (donorAddressKey) Encode(buffer []byte, key string) (int, error) {
+	if len(key) > 255 {
+		return 0, fmt.Errorf("%w: address longer than 255 bytes", collcodec.ErrEncoding)
+	}
+	buffer[0] = byte(len(key))
+	return 1 + copy(buffer[1:], key), nil
+}
+
+func (donorAddressKey) Decode(buffer []byte) (int, string, error) {
+	if len(buffer) == 0 || len(buffer) < 1+int(buffer[0]) {
+		return 0, "", fmt.Errorf("%w: truncated length-prefixed address", collcodec.ErrEncoding)
+	}
+	n := 1 + int(buffer[0])
+	return n, string(buffer[1:n]), nil
+}
+
+func (donorAddressKey) Size(key string) int { return 1 + len(key) }
+
+func (k donorAddressKey) EncodeJSON(value string) ([]byte, error) {
+	return collcodec.NewStringKeyCodec[string]().EncodeJSON(value)
+}
+
+func (k donorAddressKey) DecodeJSON(b []byte) (string, error) {
+	return collcodec.NewStringKeyCodec[string]().DecodeJSON(b)
+}
+
+func (donorAddressKey) Stringify(key string) string { return key }
+
+func (donorAddressKey) KeyType() string { return "length-prefixed-address" }
+
+func (k donorAddressKey) EncodeNonTerminal(buffer []byte, key string) (int, error) {
+	return k.Encode(buffer, key)
+}
+
+func (k donorAddressKey) DecodeNonTerminal(buffer []byte) (int, string, error) {
+	return k.Decode(buffer)
+}
+
+func (k donorAddressKey) SizeNonTerminal(key string) int { return k.Size(key) }
+
+// tierKey encodes a donor tier as a single byte
+type tierKey struct{}
+
+var _ collcodec.KeyCodec[DonorTier] = tierKey{}
+
+func (tierKey) Encode(buffer []byte, key DonorTier) (int, error) {
+	buffer[0] = byte(key)
+	return 1, nil
+}
+
+func (tierKey) Decode(buffer []byte) (int, DonorTier, error) {
+	if len(buffer) == 0 {
+		return 0, 0, fmt.Errorf("%w: missing tier byte", collcodec.ErrEncoding)
+	}
+	return 1, DonorTier(buffer[0]), nil
+}
+
+func (tierKey) Size(DonorTier) int { return 1 }
+
+func (tierKey) EncodeJSON(value DonorTier) ([]byte, error) {
+	return json.Marshal(uint8(value))
+}
+
+func (tierKey) DecodeJSON(b []byte) (DonorTier, error) {
+	var tier uint8
+	err := json.Unmarshal(b, &tier)
+	return DonorTier(tier), err
+}
+
+func (tierKey) Stringify(key DonorTier) string { return strconv.Itoa(int(key)) }
+
+func (tierKey) KeyType() string { return "donor-tier" }
+
+func (k tierKey) EncodeNonTerminal(buffer []byte, key DonorTier) (int, error) {
+	return k.Encode(buffer, key)
+}
+
+func (k tierKey) DecodeNonTerminal(buffer []byte) (int, DonorTier, error) {
+	return k.Decode(buffer)
+}
+
+func (tierKey) SizeNonTerminal(DonorTier) int { return 1 }
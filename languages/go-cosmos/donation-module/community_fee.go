@@ -0,0 +1,48 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxCommunityPoolFeeBps caps the community-pool fee at 10%
+const MaxCommunityPoolFeeBps = 1_000
+
+// validateCommunityPoolFee checks the fee in basis points
+func validateCommunityPoolFee(bps uint32) error {
+	if bps > MaxCommunityPoolFeeBps {
+		return fmt.Errorf("community pool fee %d bps above max %d", bps, MaxCommunityPoolFeeBps)
+	}
+	return nil
+}
+
+// CommunityPoolFee returns the fee on amount at bps basis points, rounded
+// down per denom
+func CommunityPoolFee(amount sdk.Coins, bps uint32) sdk.Coins {
+	fee := sdk.NewCoins()
+	if bps == 0 {
+		return fee
+	}
+
+	for _, coin := range amount {
+		share := coin.Amount.MulRaw(int64(bps)).QuoRaw(10_000)
+		fee = fee.Add(sdk.NewCoin(coin.Denom, share))
+	}
+	return fee
+}
+
+// chargeCommunityPoolFee sends Params.CommunityPoolFeeBps of a donation
+// from the module account to the community pool and returns the fee
+func (k Keeper) chargeCommunityPoolFee(ctx sdk.Context, amount sdk.Coins) (sdk.Coins, error) {
+	fee := CommunityPoolFee(amount, k.GetParams(ctx).CommunityPoolFeeBps)
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, fee, k.moduleAddress()); err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
@@ -0,0 +1,73 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DistributionSchedule pays a fraction of the withdrawable balance to the
+// registered beneficiaries every IntervalBlocks blocks, from the EndBlocker
+type DistributionSchedule struct {
+	IntervalBlocks uint64
+	Fraction       sdk.Dec // share of the withdrawable balance, in (0, 1]
+}
+
+// IsSet reports whether the schedule is enabled
+func (s DistributionSchedule) IsSet() bool {
+	return s.IntervalBlocks > 0
+}
+
+// Validate performs basic validation of the schedule
+func (s DistributionSchedule) Validate() error {
+	if !s.IsSet() {
+		return nil
+	}
+	if s.Fraction.IsNil() || !s.Fraction.IsPositive() || s.Fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution fraction must be in (0, 1]")
+	}
+	return nil
+}
+
+// IsDue reports whether a distribution runs at height
+func (s DistributionSchedule) IsDue(height int64) bool {
+	return s.IsSet() && height > 0 && uint64(height)%s.IntervalBlocks == 0
+}
+
+// runScheduledDistribution pays the scheduled fraction of the withdrawable
+// balance to the beneficiaries. Payouts are all-or-nothing: a failure
+// leaves the balance for the next interval.
+func (k Keeper) runScheduledDistribution(ctx sdk.Context, schedule DistributionSchedule) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil
+	}
+
+	if len(k.GetBeneficiaries(ctx)) == 0 {
+		return nil
+	}
+
+	amount, _ := sdk.NewDecCoinsFromCoins(state.Withdrawable()...).MulDecTruncate(schedule.Fraction).TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.payBeneficiaries(cacheCtx, amount); err != nil {
+		return err
+	}
+
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(amount...)
+	k.SetState(cacheCtx, state)
+
+	if err := cacheCtx.EventManager().EmitTypedEvent(&EventScheduledDistribution{
+		Amount:   amount,
+		Fraction: schedule.Fraction,
+		Height:   ctx.BlockHeight(),
+	}); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
@@ -0,0 +1,177 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DonationLimit bounds a single donation in one denom. Accepted IBC
+// vouchers are checked against the limit of their base denom.
+type DonationLimit struct {
+	Denom string
+	Min   sdk.Int
+	Max   sdk.Int
+}
+
+// Validate performs basic validation of a donation limit
+func (l DonationLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid donation limit denom: %w", err)
+	}
+	if l.Min.IsNil() || !l.Min.IsPositive() {
+		return fmt.Errorf("min donation for %s must be positive", l.Denom)
+	}
+	if l.Max.IsNil() || !l.Max.GT(l.Min) {
+		return fmt.Errorf("max donation for %s must be greater than min", l.Denom)
+	}
+	return nil
+}
+
+// DonationLimits holds one limit per accepted denom
+type DonationLimits []DonationLimit
+
+// Validate rejects invalid and duplicate limits
+func (ls DonationLimits) Validate() error {
+	seen := make(map[string]bool, len(ls))
+	for _, limit := range ls {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if seen[limit.Denom] {
+			return fmt.Errorf("duplicate donation limit for %s", limit.Denom)
+		}
+		seen[limit.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the limit for denom
+func (ls DonationLimits) Get(denom string) (DonationLimit, bool) {
+	for _, limit := range ls {
+		if limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return DonationLimit{}, false
+}
+
+// With returns the limits with each of updates replacing the limit of its
+// denom, or appended if the denom has none
+func (ls DonationLimits) With(updates DonationLimits) DonationLimits {
+	merged := append(DonationLimits{}, ls...)
+	for _, update := range updates {
+		replaced := false
+		for i := range merged {
+			if merged[i].Denom == update.Denom {
+				merged[i] = update
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, update)
+		}
+	}
+	return merged
+}
+
+// DonationLimitsFromCoins pairs min and max coins into per-denom limits;
+// every denom in min needs a max
+func DonationLimitsFromCoins(min, max sdk.Coins) (DonationLimits, error) {
+	if min.Len() != max.Len() {
+		return nil, fmt.Errorf("min %s and max %s must list the same denoms", min, max)
+	}
+
+	limits := make(DonationLimits, 0, min.Len())
+	for _, coin := range min {
+		limits = append(limits, DonationLimit{
+			Denom: coin.Denom,
+			Min:   coin.Amount,
+			Max:   max.AmountOf(coin.Denom),
+		})
+	}
+
+	return limits, limits.Validate()
+}
+
+// checkDonationLimits validates each denom of amount against its own limit.
+// Denoms without a limit are not accepted.
+func (k Keeper) checkDonationLimits(ctx sdk.Context, amount sdk.Coins) (reason string, err error) {
+	params := k.GetParams(ctx)
+	for _, coin := range amount {
+		denom := coin.Denom
+		if isIBCDenom(denom) {
+			if base, ok := k.resolveIBCDenom(ctx, params, denom); ok {
+				denom = base
+			}
+		}
+
+		limit, found := params.DonationLimits.Get(denom)
+		if !found {
+			return RejectDenomNotAllowed, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "no donation limit for %s", denom)
+		}
+		if coin.Amount.LT(limit.Min) {
+			return RejectBelowMin, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "donation too small: %s below %s%s", coin, limit.Min, denom)
+		}
+		if coin.Amount.GT(limit.Max) {
+			return RejectAboveMax, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "donation too large: %s above %s%s", coin, limit.Max, denom)
+		}
+	}
+
+	return "", nil
+}
+
+// UpdateDonationLimits sets the limits of the denoms in minDonation and
+// maxDonation, which must list the same denoms; limits of other denoms are
+// kept. ADMIN only, so limits can change without a governance proposal.
+// The new limits are announced like any param change and take effect
+// ParamChangeDelay blocks later. They are rejected while another change is
+// pending, which they would otherwise replace.
+func (k Keeper) UpdateDonationLimits(ctx sdk.Context, admin string, minDonation, maxDonation sdk.Coins) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, admin, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ADMIN role required")
+	}
+
+	if minDonation.IsZero() || !minDonation.IsValid() || !maxDonation.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid donation limits")
+	}
+
+	updates, err := DonationLimitsFromCoins(minDonation, maxDonation)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if pending, found := k.GetPendingParamsChange(ctx); found {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a params change is pending until height %d", pending.EffectiveHeight)
+	}
+
+	params := k.GetParams(ctx)
+	params.DonationLimits = params.DonationLimits.With(updates)
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.recordAudit(ctx, admin, AuditDonationLimits, map[string]interface{}{
+		"min_donation": minDonation.String(),
+		"max_donation": maxDonation.String(),
+	}); err != nil {
+		return err
+	}
+
+	if err := k.scheduleParams(ctx, admin, params); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventDonationLimitsUpdated{
+		Admin:       admin,
+		MinDonation: minDonation,
+		MaxDonation: maxDonation,
+	})
+}
@@ -0,0 +1,172 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StatsPeriod is the width of a donation stats bucket
+type StatsPeriod uint8
+
+const (
+	PeriodDay  StatsPeriod = 1
+	PeriodWeek StatsPeriod = 2
+)
+
+// MaxStatsBuckets caps the buckets spanned by one stats query
+const MaxStatsBuckets = 366
+
+// weekOffset shifts unix time so weekly buckets start on Monday 00:00 UTC;
+// the unix epoch was a Thursday
+const weekOffset = 3 * 24 * 60 * 60
+
+// Seconds returns the length of the period in seconds
+func (p StatsPeriod) Seconds() int64 {
+	switch p {
+	case PeriodDay:
+		return 24 * 60 * 60
+	case PeriodWeek:
+		return 7 * 24 * 60 * 60
+	default:
+		return 0
+	}
+}
+
+// Bucket returns the index of the bucket containing unix time t
+func (p StatsPeriod) Bucket(t int64) uint64 {
+	if p == PeriodWeek {
+		t += weekOffset
+	}
+	return uint64(t / p.Seconds())
+}
+
+// BucketStart returns the unix time a bucket starts at
+func (p StatsPeriod) BucketStart(bucket uint64) int64 {
+	start := int64(bucket) * p.Seconds()
+	if p == PeriodWeek {
+		start -= weekOffset
+	}
+	return start
+}
+
+// DonationBucket aggregates the donations received in one period
+type DonationBucket struct {
+	Period StatsPeriod
+	Start  int64 // unix seconds
+	Count  uint64
+	Amount sdk.Coins
+}
+
+// GetDonationBucketKey returns the store key of a stats bucket
+func GetDonationBucketKey(period StatsPeriod, bucket uint64) []byte {
+	key := append(append([]byte{}, DonationStatsKeyPrefix...), byte(period))
+	return append(key, sdk.Uint64ToBigEndian(bucket)...)
+}
+
+// addToBuckets counts a donation into its daily and weekly buckets
+func (k Keeper) addToBuckets(ctx sdk.Context, t int64, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, period := range []StatsPeriod{PeriodDay, PeriodWeek} {
+		index := period.Bucket(t)
+		key := GetDonationBucketKey(period, index)
+
+		bucket := DonationBucket{Period: period, Start: period.BucketStart(index), Amount: sdk.NewCoins()}
+		if bz := store.Get(key); bz != nil {
+			k.cdc.MustUnmarshal(bz, &bucket)
+		}
+		bucket.Count++
+		bucket.Amount = bucket.Amount.Add(amount...)
+
+		store.Set(key, k.cdc.MustMarshal(&bucket))
+	}
+}
+
+// subFromBuckets takes a refunded donation back out of the day and week
+// buckets it was counted in, deleting buckets left empty
+func (k Keeper) subFromBuckets(ctx sdk.Context, t int64, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, period := range []StatsPeriod{PeriodDay, PeriodWeek} {
+		key := GetDonationBucketKey(period, period.Bucket(t))
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var bucket DonationBucket
+		k.cdc.MustUnmarshal(bz, &bucket)
+		if bucket.Count <= 1 {
+			store.Delete(key)
+			continue
+		}
+		bucket.Count--
+		remaining, _ := bucket.Amount.SafeSub(amount...)
+		bucket.Amount = positiveCoins(remaining)
+
+		store.Set(key, k.cdc.MustMarshal(&bucket))
+	}
+}
+
+// QueryDonationStats returns the period's buckets overlapping [start, end]
+// (unix seconds), oldest first. Buckets without donations are omitted. The
+// range may span at most MaxStatsBuckets buckets.
+func (k Keeper) QueryDonationStats(ctx sdk.Context, period StatsPeriod, start, end int64) ([]DonationBucket, error) {
+	if period.Seconds() == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown stats period %d", period)
+	}
+	if start < 0 || end < start {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid stats range %d to %d", start, end)
+	}
+
+	first, last := period.Bucket(start), period.Bucket(end)
+	if last-first >= MaxStatsBuckets {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stats range spans more than %d buckets", MaxStatsBuckets)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(GetDonationBucketKey(period, first), GetDonationBucketKey(period, last+1))
+	defer iterator.Close()
+
+	buckets := []DonationBucket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket DonationBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, nil
+}
+
+// rebuildDonationIndexes recomputes the stats buckets and the time index
+// from the donation records
+func (k Keeper) rebuildDonationIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var stale [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, DonationStatsKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		stale = append(stale, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	donationsIterator, err := k.donations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	donations, err := donationsIterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	// Setting a record again rewrites its index entries
+	for _, donation := range donations {
+		k.addToBuckets(ctx, donation.Time, donation.Amount)
+		if err := k.donations.Set(ctx, donation.ID, donation); err != nil {
+			panic(err)
+		}
+	}
+}
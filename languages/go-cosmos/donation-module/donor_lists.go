@@ -0,0 +1,217 @@
+package donation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// DonorList is an admin-managed set of donor addresses
+type DonorList uint8
+
+const (
+	DonorListAllow DonorList = 1 // donors accepted while allowlist mode is on
+	DonorListBlock DonorList = 2 // donors always rejected, e.g. sanctioned
+)
+
+// String returns the list name
+func (l DonorList) String() string {
+	switch l {
+	case DonorListAllow:
+		return "ALLOW"
+	case DonorListBlock:
+		return "BLOCK"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", uint8(l))
+	}
+}
+
+// IsValid reports whether l is a known list
+func (l DonorList) IsValid() bool {
+	return l == DonorListAllow || l == DonorListBlock
+}
+
+// DonorListFromString parses a list name, case-insensitively
+func DonorListFromString(s string) (DonorList, error) {
+	switch strings.ToUpper(s) {
+	case "ALLOW":
+		return DonorListAllow, nil
+	case "BLOCK":
+		return DonorListBlock, nil
+	default:
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown donor list %q", s)
+	}
+}
+
+// GetDonorListPrefix returns the store prefix of a donor list
+func GetDonorListPrefix(list DonorList) []byte {
+	return append(DonorListKeyPrefix, byte(list))
+}
+
+// GetDonorListKey returns the store key of an address in a donor list
+func GetDonorListKey(list DonorList, addr string) []byte {
+	return append(GetDonorListPrefix(list), address.MustLengthPrefix([]byte(addr))...)
+}
+
+// validateListAddress accepts bech32 addresses of any prefix, so that
+// counterparty senders of IBC donations can be blocked too
+func validateListAddress(addr string) error {
+	if _, _, err := bech32.DecodeAndConvert(addr); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", addr, err)
+	}
+	return nil
+}
+
+// IsListed reports whether addr is in list
+func (k Keeper) IsListed(ctx sdk.Context, list DonorList, addr string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDonorListKey(list, addr))
+}
+
+// UpdateDonorList adds and removes addresses from list. Adding a listed
+// address or removing an unlisted one is a no-op.
+func (k Keeper) UpdateDonorList(ctx sdk.Context, sender string, list DonorList, add, remove []string) error {
+	if err := k.checkDonorListUpdate(ctx, sender, list); err != nil {
+		return err
+	}
+
+	for _, addr := range append(append([]string{}, add...), remove...) {
+		if err := validateListAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range add {
+		store.Set(GetDonorListKey(list, addr), []byte{})
+	}
+	for _, addr := range remove {
+		store.Delete(GetDonorListKey(list, addr))
+	}
+
+	if err := k.recordAudit(ctx, sender, AuditDonorListUpdate, map[string]interface{}{
+		"list":   list.String(),
+		"add":    add,
+		"remove": remove,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventDonorListUpdated{
+		Sender:  sender,
+		List:    list.String(),
+		Added:   add,
+		Removed: remove,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetAllowlistMode turns allowlist mode on or off. While it is on only
+// donors in the allowlist may donate.
+func (k Keeper) SetAllowlistMode(ctx sdk.Context, sender string, enabled bool) error {
+	if err := k.checkDonorListUpdate(ctx, sender, DonorListAllow); err != nil {
+		return err
+	}
+
+	state, _ := k.GetState(ctx)
+	if state.AllowlistMode == enabled {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "allowlist mode already %t", enabled)
+	}
+
+	state.AllowlistMode = enabled
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, sender, AuditAllowlistMode, map[string]interface{}{
+		"enabled": enabled,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventAllowlistModeSet{
+		Sender:  sender,
+		Enabled: enabled,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetDonorListMembers returns every address in list
+func (k Keeper) GetDonorListMembers(ctx sdk.Context, list DonorList) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetDonorListPrefix(list))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	members := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		members = append(members, listedAddress(iterator.Key()))
+	}
+
+	return members
+}
+
+// QueryDonorList returns a page of the addresses in list
+func (k Keeper) QueryDonorList(
+	ctx sdk.Context,
+	list DonorList,
+	pageReq *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetDonorListPrefix(list))
+
+	members := []string{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		members = append(members, listedAddress(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return members, pageRes, nil
+}
+
+// checkDonorLists rejects blocked donors and, in allowlist mode, donors
+// not in the allowlist. The blocklist wins over the allowlist.
+func (k Keeper) checkDonorLists(ctx sdk.Context, state DonationState, donor string) (string, error) {
+	if k.IsListed(ctx, DonorListBlock, donor) {
+		return RejectDonorBlocked, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "donor %s is blocked", donor)
+	}
+
+	if state.AllowlistMode && !k.IsListed(ctx, DonorListAllow, donor) {
+		return RejectDonorNotAllowed, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "donor %s is not allowlisted", donor)
+	}
+
+	return "", nil
+}
+
+func (k Keeper) checkDonorListUpdate(ctx sdk.Context, sender string, list DonorList) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can manage donor lists")
+	}
+
+	if !list.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown donor list %d", list)
+	}
+
+	return nil
+}
+
+// listedAddress strips the length prefix from a donor list key
+func listedAddress(key []byte) string {
+	return string(key[1:])
+}
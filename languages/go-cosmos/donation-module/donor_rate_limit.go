@@ -0,0 +1,111 @@
+package donation
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DonorRateLimit caps how much a single donor may give within a rolling
+// window of blocks, so tiers cannot be farmed with bursts of donations.
+// Accepted IBC vouchers count toward the cap of their base denom.
+type DonorRateLimit struct {
+	WindowBlocks uint64
+	Max          sdk.Coins // per-denom cap; denoms not listed are uncapped
+}
+
+// IsSet reports whether the rate limit is enabled
+func (l DonorRateLimit) IsSet() bool {
+	return l.WindowBlocks > 0 && !l.Max.Empty()
+}
+
+// Validate performs basic validation of the rate limit
+func (l DonorRateLimit) Validate() error {
+	if l.Max.Empty() {
+		return nil
+	}
+	if l.WindowBlocks == 0 {
+		return fmt.Errorf("donor rate limit needs a window")
+	}
+	if err := l.Max.Validate(); err != nil {
+		return fmt.Errorf("invalid donor rate limit: %w", err)
+	}
+	return nil
+}
+
+// checkDonorRateLimit rejects a donation that would take the donor's total
+// in the current window above the cap. The window is read back from the
+// donor's donation history, newest first, so no separate counter is kept.
+func (k Keeper) checkDonorRateLimit(ctx sdk.Context, donor string, amount sdk.Coins) (reason string, err error) {
+	params := k.GetParams(ctx)
+	limit := params.DonorRateLimit
+	if !limit.IsSet() {
+		return "", nil
+	}
+
+	total := k.baseDenomCoins(ctx, params, amount)
+	if !hasCappedDenom(total, limit.Max) {
+		return "", nil
+	}
+
+	since := ctx.BlockHeight() - int64(limit.WindowBlocks)
+	iterator, err := k.donations.Indexes.Donor.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](donor).Descending())
+	if err != nil {
+		return "", err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id, err := iterator.PrimaryKey()
+		if err != nil {
+			return "", err
+		}
+		donation, found := k.GetDonation(ctx, id)
+		if !found {
+			continue
+		}
+		if donation.Height <= since {
+			break
+		}
+		total = total.Add(k.baseDenomCoins(ctx, params, donation.Amount)...)
+	}
+
+	for _, capped := range limit.Max {
+		if total.AmountOf(capped.Denom).GT(capped.Amount) {
+			return RejectRateLimited, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"donor %s would give %s%s in %d blocks, above the cap of %s",
+				donor, total.AmountOf(capped.Denom), capped.Denom, limit.WindowBlocks, capped,
+			)
+		}
+	}
+
+	return "", nil
+}
+
+// baseDenomCoins replaces accepted IBC vouchers in coins with their base denom
+func (k Keeper) baseDenomCoins(ctx sdk.Context, params Params, coins sdk.Coins) sdk.Coins {
+	resolved := sdk.NewCoins()
+	for _, coin := range coins {
+		denom := coin.Denom
+		if isIBCDenom(denom) {
+			if base, ok := k.resolveIBCDenom(ctx, params, denom); ok {
+				denom = base
+			}
+		}
+		resolved = resolved.Add(sdk.NewCoin(denom, coin.Amount))
+	}
+	return resolved
+}
+
+// hasCappedDenom reports whether coins holds any denom listed in max
+func hasCappedDenom(coins, max sdk.Coins) bool {
+	for _, coin := range coins {
+		if max.AmountOf(coin.Denom).IsPositive() {
+			return true
+		}
+	}
+	return false
+}
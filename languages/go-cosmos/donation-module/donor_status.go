@@ -0,0 +1,106 @@
+package donation
+
+import (
+	"bytes"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DonorStatus summarizes a donor's standing for wallets: their tier and
+// totals, leaderboard rank, and how far they are from the next tier
+type DonorStatus struct {
+	Tier          DonorTier
+	TotalDonated  sdk.Coins
+	TotalUSD      sdk.Dec
+	DonationCount uint64
+	Rank          uint64 // 1-based leaderboard position, 0 if unranked
+
+	// NextTier is the tier above Tier, TierNone at the top. With USD tiers
+	// the gap is RemainingUSD, otherwise Remaining, a tier score in
+	// tier-denom units (weighted by Params.TierWeights).
+	NextTier     DonorTier
+	Remaining    sdkmath.Int
+	RemainingUSD sdk.Dec
+}
+
+// GetDonorStatus returns a donor's status. Both the tier and the gap to the
+// next tier use the contribution decayed to the current block. An address
+// that has never donated gets a zero status with the gap to the first tier.
+func (k Keeper) GetDonorStatus(ctx sdk.Context, addr string) DonorStatus {
+	donor, found := k.GetDonor(ctx, addr)
+	if !found {
+		donor = DonorRecord{Address: addr, TotalDonated: sdk.NewCoins(), TotalUSD: sdk.ZeroDec()}
+	}
+
+	totalUSD := donor.TotalUSD
+	if totalUSD.IsNil() {
+		totalUSD = sdk.ZeroDec()
+	}
+
+	status := DonorStatus{
+		Tier:          k.EffectiveTier(ctx, donor),
+		TotalDonated:  donor.TotalDonated,
+		TotalUSD:      totalUSD,
+		DonationCount: k.donationCount(ctx, addr),
+		Rank:          k.leaderboardRank(ctx, addr),
+		Remaining:     sdkmath.ZeroInt(),
+		RemainingUSD:  sdk.ZeroDec(),
+	}
+	if status.Tier == TierPlatinum {
+		return status
+	}
+	status.NextTier = status.Tier + 1
+
+	coins, usd := k.effectiveContribution(ctx, donor)
+	thresholds := k.GetParams(ctx).USDTierThresholds
+	if k.oracleKeeper != nil && thresholds.IsSet() {
+		status.RemainingUSD = thresholds.Min(status.NextTier).Sub(usd)
+		return status
+	}
+
+	for _, t := range tierThresholds {
+		if t.tier == status.NextTier {
+			status.Remaining = t.min.Sub(k.tierScore(ctx, coins))
+		}
+	}
+	return status
+}
+
+// donationCount returns the number of donations a donor has made, read
+// from the donor's donation index
+func (k Keeper) donationCount(ctx sdk.Context, addr string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetDonorDonationsPrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// leaderboardRank returns the donor's 1-based leaderboard position, or 0 if
+// they are not on the leaderboard. It walks the entries above the donor,
+// so it costs O(rank).
+func (k Keeper) leaderboardRank(ctx sdk.Context, addr string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(GetLeaderboardPositionKey(addr))
+	if key == nil {
+		return 0
+	}
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, LeaderboardKeyPrefix)
+	defer iterator.Close()
+
+	var rank uint64
+	for ; iterator.Valid(); iterator.Next() {
+		rank++
+		if bytes.Equal(iterator.Key(), key) {
+			return rank
+		}
+	}
+	return 0
+}
@@ -0,0 +1,106 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ErrDustDonation is returned for donations below the dust threshold of
+// their denom
+var ErrDustDonation = sdkerrors.Register(ModuleName, 2, "donation below dust threshold")
+
+// validateDustThresholds checks the per-denom dust thresholds
+func validateDustThresholds(thresholds sdk.Coins) error {
+	if err := thresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid dust thresholds: %w", err)
+	}
+	return nil
+}
+
+// dustThreshold returns the dust threshold of denom; accepted IBC vouchers
+// use the threshold of their base denom
+func (k Keeper) dustThreshold(ctx sdk.Context, params Params, denom string) sdk.Int {
+	if isIBCDenom(denom) {
+		if base, ok := k.resolveIBCDenom(ctx, params, denom); ok {
+			denom = base
+		}
+	}
+	return params.DustThresholds.AmountOf(denom)
+}
+
+// checkDust rejects donations with any denom below its dust threshold
+func (k Keeper) checkDust(ctx sdk.Context, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range amount {
+		threshold := k.dustThreshold(ctx, params, coin.Denom)
+		if coin.Amount.LT(threshold) {
+			return sdkerrors.Wrapf(ErrDustDonation, "%s below %s", coin, threshold)
+		}
+	}
+	return nil
+}
+
+// SweepDust sends every module account balance below its denom's dust
+// threshold to the community pool. Dust in the donations balance is
+// recorded as withdrawn; the matching pool, challenge escrow and reward
+// funds are never swept. Governance or
+// ADMIN only.
+func (k Keeper) SweepDust(ctx sdk.Context, sender string) (sdk.Coins, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if sender != k.authority && !k.HasRole(ctx, sender, RoleAdmin) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance or ADMIN can sweep dust")
+	}
+
+	params := k.GetParams(ctx)
+	moduleAddr := k.moduleAddress()
+	matching := k.GetMatchingPool(ctx)
+	rewards := k.GetRewardPool(ctx)
+	pool := matching.Balance.Add(matching.Escrowed...).Add(rewards.Balance...).Add(rewards.Allocated...).Add(state.HeldDonations...)
+	withdrawable := state.Withdrawable()
+
+	swept, fromDonations := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, moduleAddr) {
+		residual := coin.Amount.Sub(pool.AmountOf(coin.Denom))
+		if !residual.IsPositive() || residual.GTE(k.dustThreshold(ctx, params, coin.Denom)) {
+			continue
+		}
+
+		swept = swept.Add(sdk.NewCoin(coin.Denom, residual))
+		tracked := sdk.MinInt(residual, withdrawable.AmountOf(coin.Denom))
+		if tracked.IsPositive() {
+			fromDonations = fromDonations.Add(sdk.NewCoin(coin.Denom, tracked))
+		}
+	}
+
+	if swept.IsZero() {
+		return swept, nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, swept, moduleAddr); err != nil {
+		return nil, err
+	}
+
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(fromDonations...)
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, sender, AuditSweepDust, map[string]interface{}{
+		"amount": swept.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventDustSwept{
+		Sender: sender,
+		Amount: swept,
+	}); err != nil {
+		return nil, err
+	}
+
+	return swept, nil
+}
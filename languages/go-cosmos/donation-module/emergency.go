@@ -0,0 +1,116 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PendingEmergencyWithdrawal is an emergency withdrawal waiting out its timelock
+type PendingEmergencyWithdrawal struct {
+	Proposer         string
+	Recipient        string
+	ScheduledHeight  int64
+	ExecutableHeight int64
+}
+
+// ScheduleEmergencyWithdraw starts the timelock for an emergency withdrawal.
+// Execution is possible once Params.EmergencyWithdrawDelay blocks have passed,
+// giving donors and governance time to react.
+func (k Keeper) ScheduleEmergencyWithdraw(
+	ctx sdk.Context,
+	admin string,
+	recipient string,
+) (PendingEmergencyWithdrawal, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return PendingEmergencyWithdrawal{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, admin, RoleWithdrawer) {
+		return PendingEmergencyWithdrawal{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "WITHDRAWER role required")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return PendingEmergencyWithdrawal{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if _, found := k.GetPendingEmergencyWithdrawal(ctx); found {
+		return PendingEmergencyWithdrawal{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "emergency withdrawal already scheduled")
+	}
+
+	params := k.GetParams(ctx)
+	pending := PendingEmergencyWithdrawal{
+		Proposer:         admin,
+		Recipient:        recipient,
+		ScheduledHeight:  ctx.BlockHeight(),
+		ExecutableHeight: ctx.BlockHeight() + int64(params.EmergencyWithdrawDelay),
+	}
+	k.setPendingEmergencyWithdrawal(ctx, pending)
+
+	if err := k.recordAudit(ctx, admin, AuditEmergencyWithdrawScheduled, map[string]interface{}{
+		"recipient": recipient,
+	}); err != nil {
+		return PendingEmergencyWithdrawal{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventEmergencyWithdrawalScheduled{
+		Admin:            admin,
+		Recipient:        recipient,
+		ExecutableHeight: pending.ExecutableHeight,
+		Timestamp:        ctx.BlockTime().Unix(),
+	}); err != nil {
+		return PendingEmergencyWithdrawal{}, err
+	}
+
+	return pending, nil
+}
+
+// CancelEmergencyWithdraw cancels a scheduled emergency withdrawal
+func (k Keeper) CancelEmergencyWithdraw(ctx sdk.Context, admin string) error {
+	if !k.HasRole(ctx, admin, RoleWithdrawer) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "WITHDRAWER role required")
+	}
+
+	pending, found := k.GetPendingEmergencyWithdrawal(ctx)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "no emergency withdrawal scheduled")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PendingEmergencyWithdrawalKey)
+
+	if err := k.recordAudit(ctx, admin, AuditEmergencyWithdrawCancelled, map[string]interface{}{
+		"recipient": pending.Recipient,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventEmergencyWithdrawalCancelled{
+		Admin:     admin,
+		Recipient: pending.Recipient,
+		Timestamp: ctx.BlockTime().Unix(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPendingEmergencyWithdrawal returns the scheduled emergency withdrawal, if any
+func (k Keeper) GetPendingEmergencyWithdrawal(ctx sdk.Context) (PendingEmergencyWithdrawal, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PendingEmergencyWithdrawalKey)
+	if bz == nil {
+		return PendingEmergencyWithdrawal{}, false
+	}
+
+	var pending PendingEmergencyWithdrawal
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+func (k Keeper) setPendingEmergencyWithdrawal(ctx sdk.Context, pending PendingEmergencyWithdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pending)
+	store.Set(PendingEmergencyWithdrawalKey, bz)
+}
@@ -0,0 +1,203 @@
+package donation
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EscrowedDonation is a donation held in the module account during the
+// escrow period. The donor can claw it back until ReleaseHeight; after that
+// it is recorded like any other donation.
+type EscrowedDonation struct {
+	ID            uint64
+	Donor         string
+	CampaignID    uint64 // 0 for a general donation
+	Amount        sdk.Coins
+	Memo          string
+	Anonymous     bool
+	Height        int64 // block the donation was made in
+	ReleaseHeight int64
+}
+
+// escrowEnabled reports whether donations are held in escrow
+func (k Keeper) escrowEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EscrowBlocks > 0
+}
+
+// EscrowDonation accepts a donation into escrow for Params.EscrowBlocks
+// blocks. It is checked like an immediate donation, but only counts toward
+// TotalDonations, the donor's tier and the campaign once released.
+func (k Keeper) EscrowDonation(
+	ctx sdk.Context,
+	donor string,
+	campaignID uint64,
+	amount sdk.Coins,
+	memo string,
+	anonymous bool,
+) (uint64, error) {
+	if err := k.checkDonation(ctx, donor, amount, memo); err != nil {
+		return 0, err
+	}
+	if campaignID != 0 {
+		if err := k.checkCampaignDonation(ctx, campaignID, amount); err != nil {
+			return 0, err
+		}
+	}
+
+	seq, err := k.escrowSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	escrow := EscrowedDonation{
+		ID:            seq + 1,
+		Donor:         donor,
+		CampaignID:    campaignID,
+		Amount:        amount,
+		Memo:          memo,
+		Anonymous:     anonymous,
+		Height:        ctx.BlockHeight(),
+		ReleaseHeight: ctx.BlockHeight() + int64(k.GetParams(ctx).EscrowBlocks),
+	}
+	if err := k.escrows.Set(ctx, escrow.ID, escrow); err != nil {
+		panic(err)
+	}
+	if err := k.escrowQueue.Set(ctx, collections.Join(uint64(escrow.ReleaseHeight), escrow.ID)); err != nil {
+		panic(err)
+	}
+
+	state, _ := k.GetState(ctx)
+	state.HeldDonations = state.HeldDonations.Add(amount...)
+	k.SetState(ctx, state)
+
+	donorRecord, _ := k.GetDonor(ctx, donor)
+	donorRecord.Address = donor
+	donorRecord.Anonymous = donorRecord.Anonymous || anonymous
+	return escrow.ID, ctx.EventManager().EmitTypedEvent(&EventDonationEscrowed{
+		ID:            escrow.ID,
+		Donor:         publicDonor(donorRecord),
+		CampaignID:    campaignID,
+		Amount:        amount,
+		ReleaseHeight: escrow.ReleaseHeight,
+	})
+}
+
+// Clawback returns an escrowed donation to its donor before its release
+// height. Only the donor can claw back.
+func (k Keeper) Clawback(ctx sdk.Context, donor string, id uint64) (sdk.Coins, error) {
+	escrow, found := k.GetEscrowedDonation(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "escrowed donation %d not found", id)
+	}
+
+	if escrow.Donor != donor {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the donor can claw back a donation")
+	}
+
+	// A release that failed and awaits a retry is past its window too
+	if ctx.BlockHeight() >= escrow.ReleaseHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the clawback window of escrowed donation %d closed at height %d", id, escrow.ReleaseHeight)
+	}
+
+	donorAddr, err := sdk.AccAddressFromBech32(donor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, donorAddr, escrow.Amount); err != nil {
+		return nil, err
+	}
+
+	k.removeEscrow(ctx, escrow)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventDonationClawedBack{
+		ID:     escrow.ID,
+		Donor:  donor,
+		Amount: escrow.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return escrow.Amount, nil
+}
+
+// releaseEscrows records the escrowed donations whose clawback window has
+// closed. Each release is all-or-nothing; a failed one is retried next
+// block.
+func (k Keeper) releaseEscrows(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(uint64(ctx.BlockHeight())+1, uint64(0)))
+	due, err := k.escrowQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		escrow, found := k.GetEscrowedDonation(ctx, key.K2())
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "escrowed donation %d", key.K2())
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseEscrow(cacheCtx, escrow); err != nil {
+			ctx.Logger().Error("failed to release escrowed donation", "id", escrow.ID, "err", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// releaseEscrow records an escrowed donation. It was checked when made, so
+// it is credited even if, say, donations have since been paused.
+func (k Keeper) releaseEscrow(ctx sdk.Context, escrow EscrowedDonation) error {
+	k.removeEscrow(ctx, escrow)
+
+	donation, err := k.creditDonation(ctx, escrow.Donor, escrow.Amount, escrow.Memo, escrow.Anonymous)
+	if err != nil {
+		return err
+	}
+	if escrow.CampaignID != 0 {
+		if err := k.creditCampaign(ctx, donation, escrow.CampaignID); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventDonationReleased{
+		ID:        escrow.ID,
+		ReceiptID: donation.ReceiptID,
+	})
+}
+
+// removeEscrow deletes an escrowed donation and releases its hold
+func (k Keeper) removeEscrow(ctx sdk.Context, escrow EscrowedDonation) {
+	if err := k.escrows.Remove(ctx, escrow.ID); err != nil {
+		panic(err)
+	}
+	if err := k.escrowQueue.Remove(ctx, collections.Join(uint64(escrow.ReleaseHeight), escrow.ID)); err != nil {
+		panic(err)
+	}
+
+	state, _ := k.GetState(ctx)
+	state.HeldDonations = state.HeldDonations.Sub(escrow.Amount...)
+	k.SetState(ctx, state)
+}
+
+// GetEscrowedDonation returns an escrowed donation by ID
+func (k Keeper) GetEscrowedDonation(ctx sdk.Context, id uint64) (EscrowedDonation, bool) {
+	escrow, err := k.escrows.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return EscrowedDonation{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return escrow, true
+}
@@ -0,0 +1,558 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Typed events emitted via EmitTypedEvent. These mirror the messages in
+// proto/donation/v1/events.proto; indexers receive them as events of type
+// "donation.v1.<Name>" with JSON-encoded attributes. Donor fields hold
+// AnonymousDonor for anonymous donors.
+
+// EventInitialized is emitted when the module is initialized
+type EventInitialized struct {
+	Admin       string
+	MinDonation sdk.Coins
+	MaxDonation sdk.Coins
+}
+
+// EventDonationReceived is emitted for every accepted donation
+type EventDonationReceived struct {
+	Donor     string
+	Amount    sdk.Coins
+	Matched   sdk.Coins
+	Total     sdk.Coins
+	Tier      DonorTier
+	Timestamp int64
+	USDValue  sdk.Dec // zero without an oracle
+	Memo      string
+	Burned    sdk.Coins // part of Amount burned at Params.BurnRate
+	Fee       sdk.Coins // part of Amount sent to the community pool
+	ReceiptID string    // see DonationReceiptID
+}
+
+// EventWithdrawal is emitted when funds are withdrawn
+type EventWithdrawal struct {
+	Admin     string
+	Amount    sdk.Coins
+	Recipient string // empty when split across beneficiaries
+	Band      string // approval band: single, multisig or governance
+	Timestamp int64
+}
+
+// EventEmergencyWithdrawal is emitted when all funds are withdrawn
+type EventEmergencyWithdrawal struct {
+	Admin     string
+	Amount    sdk.Coins
+	Recipient string
+	Timestamp int64
+}
+
+// EventDonationRefunded is emitted when a donation is refunded
+type EventDonationRefunded struct {
+	Admin      string
+	Donor      string
+	Amount     sdk.Coins
+	Total      sdk.Coins
+	Tier       DonorTier
+	Timestamp  int64
+	DonationID uint64
+	Band       string // approval band: single, multisig or governance
+}
+
+// EventPaused is emitted when donations are paused
+type EventPaused struct {
+	Admin         string
+	Timestamp     int64
+	Reason        string
+	UnpauseHeight int64 // 0 if the pause has no scheduled end
+}
+
+// EventUnpaused is emitted when donations are resumed
+type EventUnpaused struct {
+	Admin     string // empty when unpaused automatically
+	Timestamp int64
+	Automatic bool // unpaused by BeginBlocker at the scheduled height
+}
+
+// EventAdminTransferProposed is emitted when an admin transfer is proposed
+type EventAdminTransferProposed struct {
+	Admin        string
+	PendingAdmin string
+	Timestamp    int64
+}
+
+// EventAdminTransferred is emitted when a pending admin accepts
+type EventAdminTransferred struct {
+	PreviousAdmin string
+	Admin         string
+	Timestamp     int64
+}
+
+// EventDonorSetAnchored is emitted when a donor-set root is anchored
+type EventDonorSetAnchored struct {
+	Epoch      uint64
+	Height     int64
+	Root       []byte
+	DonorCount uint64
+}
+
+// EventCampaignCreated is emitted when a campaign is created
+type EventCampaignCreated struct {
+	CampaignID  uint64
+	Creator     string
+	Beneficiary string
+	Goal        sdk.Coins
+	Deadline    int64
+
+	AcceptedDenoms []string
+}
+
+// EventCampaignDonation is emitted for donations directed to a campaign
+type EventCampaignDonation struct {
+	CampaignID uint64
+	Donor      string
+	Amount     sdk.Coins
+	Raised     sdk.Coins
+}
+
+// EventCampaignArchived is emitted when a campaign is archived
+type EventCampaignArchived struct {
+	CampaignID uint64
+	Sender     string
+	Raised     sdk.Coins
+}
+
+// EventCampaignUnarchived is emitted when governance reopens a campaign
+type EventCampaignUnarchived struct {
+	CampaignID uint64
+	Authority  string
+}
+
+// EventEmergencyWithdrawalScheduled is emitted when an emergency
+// withdrawal is scheduled
+type EventEmergencyWithdrawalScheduled struct {
+	Admin            string
+	Recipient        string
+	ExecutableHeight int64
+	Timestamp        int64
+}
+
+// EventEmergencyWithdrawalCancelled is emitted when a scheduled emergency
+// withdrawal is cancelled
+type EventEmergencyWithdrawalCancelled struct {
+	Admin     string
+	Recipient string
+	Timestamp int64
+}
+
+// EventIBCDonationReceived is emitted for donations arriving over ICS-20
+type EventIBCDonationReceived struct {
+	Donor         string
+	Amount        sdk.Coins
+	CampaignID    uint64
+	SourceChannel string
+	DestChannel   string
+}
+
+// EventIdentityAttested is emitted when an identity attestation is recorded
+type EventIdentityAttested struct {
+	Donor          string
+	Issuer         string
+	CredentialHash string
+	ExpiresAt      int64
+	Attester       string
+}
+
+// EventMatchingPoolFunded is emitted when a sponsor funds the matching pool
+type EventMatchingPoolFunded struct {
+	Sponsor string
+	Amount  sdk.Coins
+	Balance sdk.Coins
+}
+
+// EventMatchingPoolConfigured is emitted when the matching pool is configured
+type EventMatchingPoolConfigured struct {
+	Authority string
+	Ratio     sdk.Dec
+	Active    bool
+}
+
+// EventParamsUpdated is emitted when governance updates the params
+type EventParamsUpdated struct {
+	Authority string
+}
+
+// EventParamsChangeScheduled is emitted when governance announces new params
+type EventParamsChangeScheduled struct {
+	Authority       string
+	EffectiveHeight int64
+}
+
+// EventParamsChangeCancelled is emitted when a pending params change is dropped
+type EventParamsChangeCancelled struct {
+	Authority string
+}
+
+// EventRoleGranted is emitted when a role is granted
+type EventRoleGranted struct {
+	Sender  string
+	Address string
+	Role    string
+}
+
+// EventRoleRevoked is emitted when a role is revoked
+type EventRoleRevoked struct {
+	Sender  string
+	Address string
+	Role    string
+}
+
+// EventTeamCreated is emitted when a team is created
+type EventTeamCreated struct {
+	TeamID     uint64
+	Name       string
+	Creator    string
+	CampaignID uint64
+}
+
+// EventTeamJoined is emitted when a donor joins a team
+type EventTeamJoined struct {
+	TeamID uint64
+	Member string
+}
+
+// EventTeamLeft is emitted when a donor leaves a team
+type EventTeamLeft struct {
+	TeamID uint64
+	Member string
+}
+
+// EventCampaignUpdatePosted is emitted when a campaign update is posted
+type EventCampaignUpdatePosted struct {
+	CampaignID  uint64
+	Index       uint64
+	Author      string
+	URI         string
+	ContentHash []byte
+}
+
+// EventDonorListUpdated is emitted when addresses are added to or removed
+// from a donor list
+type EventDonorListUpdated struct {
+	Sender  string
+	List    string
+	Added   []string
+	Removed []string
+}
+
+// EventAllowlistModeSet is emitted when allowlist mode is turned on or off
+type EventAllowlistModeSet struct {
+	Sender  string
+	Enabled bool
+}
+
+// EventBatchDonation is emitted once for a batch donation in place of the
+// per-entry donation events
+type EventBatchDonation struct {
+	Donor   string
+	Entries []BatchDonationEntry
+	Total   sdk.Coins
+	Tier    DonorTier
+}
+
+// EventBeneficiariesSet is emitted when the beneficiaries are replaced
+type EventBeneficiariesSet struct {
+	Sender        string
+	Beneficiaries []Beneficiary
+}
+
+// EventBeneficiaryPaid is emitted for each beneficiary share of a split
+// withdrawal
+type EventBeneficiaryPaid struct {
+	Beneficiary string
+	Amount      sdk.Coins
+	Weight      uint64
+}
+
+// EventScheduledDistribution is emitted when the EndBlocker pays the
+// scheduled share of the balance to the beneficiaries
+type EventScheduledDistribution struct {
+	Amount   sdk.Coins
+	Fraction sdk.Dec
+	Height   int64
+}
+
+// EventCampaignExtended is emitted when a campaign's deadline is extended
+// by its extension policy
+type EventCampaignExtended struct {
+	CampaignID       uint64
+	PreviousDeadline int64
+	Deadline         int64
+	Extension        uint32 // 1 for the first extension
+	FundedFraction   sdk.Dec
+}
+
+// EventOrganizationRegistered is emitted when an organization is added to
+// or updated in the beneficiary registry
+type EventOrganizationRegistered struct {
+	Sender      string
+	Address     string
+	Name        string
+	MetadataURI string
+	Status      string
+}
+
+// EventOrganizationStatusChanged is emitted when an organization is
+// verified or revoked
+type EventOrganizationStatusChanged struct {
+	Sender         string
+	Address        string
+	PreviousStatus string
+	Status         string
+}
+
+// EventDustSwept is emitted when module account dust is sent to the
+// community pool
+type EventDustSwept struct {
+	Sender string
+	Amount sdk.Coins
+}
+
+// EventFundsDelegated is emitted when the admin delegates donation funds
+type EventFundsDelegated struct {
+	Sender    string
+	Validator string
+	Amount    sdk.Coin
+}
+
+// EventFundsUndelegated is emitted when the admin starts unbonding
+// delegated donation funds
+type EventFundsUndelegated struct {
+	Sender         string
+	Validator      string
+	Amount         sdk.Coin
+	CompletionTime int64
+}
+
+// EventUnbondingCompleted is emitted when unbonded funds become
+// withdrawable again
+type EventUnbondingCompleted struct {
+	Validator string
+	Amount    sdk.Coin
+}
+
+// EventStakingRewardsClaimed is emitted when delegation rewards are
+// credited to the donations
+type EventStakingRewardsClaimed struct {
+	Validator string
+	Amount    sdk.Coins
+}
+
+// EventCampaignBoosted is emitted when a sponsor buys a campaign boost
+type EventCampaignBoosted struct {
+	BoostID    uint64
+	CampaignID uint64
+	Sponsor    string
+	Amount     sdk.Coin
+	Expiry     int64
+	Burned     bool // payment burned rather than sent to the community pool
+}
+
+// EventVestingScheduleSet is emitted when a vesting schedule is set, or
+// cancelled with a zero total
+type EventVestingScheduleSet struct {
+	Sender      string
+	Beneficiary string
+	Total       sdk.Coins
+	Start       int64
+	Cliff       int64
+	Duration    int64
+}
+
+// EventVestingReleased is emitted when the EndBlocker pays an unlocked
+// vesting tranche
+type EventVestingReleased struct {
+	Beneficiary string
+	Amount      sdk.Coins
+	Released    sdk.Coins // released so far, including Amount
+	Total       sdk.Coins
+}
+
+// EventStreakMilestone is emitted when a donor's streak of consecutive
+// donation epochs reaches one of Params.Loyalty.Milestones
+type EventStreakMilestone struct {
+	Donor  string
+	Streak uint64
+	Epoch  int64
+	Points uint64 // loyalty points after this epoch's award
+}
+
+// EventChallengeMatchCreated is emitted when a sponsor escrows a challenge
+// match for a campaign
+type EventChallengeMatchCreated struct {
+	ID         uint64
+	CampaignID uint64
+	Sponsor    string
+	Target     sdk.Coin
+	Deadline   int64
+	Match      sdk.Coins
+}
+
+// EventChallengeMatchResolved is emitted when the EndBlocker donates a
+// triggered challenge match or refunds an expired one
+type EventChallengeMatchResolved struct {
+	ID         uint64
+	CampaignID uint64
+	Sponsor    string
+	Match      sdk.Coins
+	Triggered  bool // donated to the campaign rather than refunded
+}
+
+// EventDonationLimitsUpdated is emitted when an ADMIN changes the donation
+// limits of some denoms. The limits are announced as a param change and
+// apply ParamChangeDelay blocks later.
+type EventDonationLimitsUpdated struct {
+	Admin       string
+	MinDonation sdk.Coins
+	MaxDonation sdk.Coins
+}
+
+// EventCircuitBreakerUpdated is emitted when Msgs are disabled or
+// re-enabled by the circuit breaker
+type EventCircuitBreakerUpdated struct {
+	Sender   string
+	TypeURLs []string
+	Disabled bool
+}
+
+// EventDonorSnapshotTaken is emitted when the EndBlocker computes a
+// scheduled donor snapshot
+type EventDonorSnapshotTaken struct {
+	ID         uint64
+	Creator    string
+	Height     int64
+	Root       []byte
+	DonorCount uint64
+}
+
+// EventRewardPoolFunded is emitted when a sponsor funds the reward pool
+type EventRewardPoolFunded struct {
+	Sponsor string
+	Amount  sdk.Coins
+	Balance sdk.Coins // pool balance available for distributions
+}
+
+// EventRewardsDistributed is emitted when an ADMIN allocates rewards to
+// donors
+type EventRewardsDistributed struct {
+	ID         uint64
+	Admin      string
+	Allocated  sdk.Coins
+	Recipients uint64
+	ExpiresAt  int64
+}
+
+// EventRewardClaimed is emitted when a donor claims a reward
+type EventRewardClaimed struct {
+	DistributionID uint64
+	Donor          string
+	Amount         sdk.Coins
+}
+
+// EventRewardsExpired is emitted when a distribution's claim period ends
+// and its unclaimed rewards return to the pool
+type EventRewardsExpired struct {
+	ID        uint64
+	Unclaimed sdk.Coins
+}
+
+// EventRemoteDonationAccountRegistered is emitted when the module's
+// interchain account is registered on a connection
+type EventRemoteDonationAccountRegistered struct {
+	Sender       string
+	ConnectionID string
+	PortID       string
+}
+
+// EventDonationForwarded is emitted when funds are sent to another chain
+// for a forwarded donation, including on retries
+type EventDonationForwarded struct {
+	ID           uint64
+	Sender       string
+	ConnectionID string
+	Amount       sdk.Coin
+	Recipient    string
+	Attempt      uint32
+}
+
+// EventForwardStatusChanged is emitted when a forwarded donation reaches a
+// new stage or fails
+type EventForwardStatusChanged struct {
+	ID     uint64
+	Status string
+	Error  string
+}
+
+// EventDonationEscrowed is emitted when a donation is held in escrow.
+// Donor is empty for anonymous donations.
+type EventDonationEscrowed struct {
+	ID            uint64
+	Donor         string
+	CampaignID    uint64
+	Amount        sdk.Coins
+	ReleaseHeight int64
+}
+
+// EventDonationClawedBack is emitted when a donor claws back an escrowed
+// donation
+type EventDonationClawedBack struct {
+	ID     uint64
+	Donor  string
+	Amount sdk.Coins
+}
+
+// EventDonationReleased is emitted when an escrowed donation is recorded,
+// alongside the usual EventDonationReceived
+type EventDonationReleased struct {
+	ID        uint64
+	ReceiptID string
+}
+
+// EventCampaignFinalized is emitted when the EndBlocker closes a campaign
+// past its deadline. PayoutHeight is 0 unless a payout was scheduled.
+type EventCampaignFinalized struct {
+	CampaignID   uint64
+	Succeeded    bool
+	Goal         sdk.Coins
+	Raised       sdk.Coins
+	PayoutHeight int64
+}
+
+// EventCampaignPaidOut is emitted when a successful campaign's raised
+// amount is paid to its beneficiary
+type EventCampaignPaidOut struct {
+	CampaignID  uint64
+	Beneficiary string
+	Amount      sdk.Coins
+}
+
+// EventCampaignRefundClaimed is emitted when a donor claims back their
+// contribution to a failed campaign. Donor is empty for anonymous donors.
+type EventCampaignRefundClaimed struct {
+	CampaignID uint64
+	Donor      string
+	Amount     sdk.Coins
+}
+
+// EventDonationsMatched is emitted when a sponsor retroactively matches the
+// donations in a block range
+type EventDonationsMatched struct {
+	Sponsor     string
+	StartHeight int64
+	EndHeight   int64
+	Ratio       sdk.Dec
+	Donations   uint64
+	Donors      uint64
+	Matched     sdk.Coins
+}
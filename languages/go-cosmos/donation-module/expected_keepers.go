@@ -0,0 +1,79 @@
+package donation
+
+import (
+	"context"
+	"time"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+//go:generate mockgen -source=expected_keepers.go -destination=testutil/expected_keepers_mocks.go -package=testutil
+
+// AccountKeeper defines the x/auth functionality used to resolve the
+// module account
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the bank functionality needed by the donation module
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the x/distribution functionality used to fund the
+// community pool and claim staking rewards
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+}
+
+// StakingKeeper defines the x/staking functionality used to delegate idle
+// donation funds
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (sdk.Dec, error)
+	ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Int) (sdk.Dec, error)
+	Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (time.Time, error)
+}
+
+// NFTKeeper defines the x/nft functionality used to mint donor badges
+type NFTKeeper interface {
+	SaveClass(ctx sdk.Context, class nft.Class) error
+	HasClass(ctx sdk.Context, classID string) bool
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Update(ctx sdk.Context, token nft.NFT) error
+	HasNFT(ctx sdk.Context, classID, id string) bool
+}
+
+// TransferKeeper defines the ICS-20 functionality used to resolve IBC denoms
+// and forward donations to other chains
+type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool)
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// ICAControllerKeeper defines the interchain accounts controller
+// functionality used to forward donations to other chains
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+}
+
+// OracleKeeper defines the price feed used to value donations in USD
+type OracleKeeper interface {
+	// GetUSDPrice returns the USD price of one base unit of denom
+	GetUSDPrice(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
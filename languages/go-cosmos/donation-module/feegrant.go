@@ -0,0 +1,33 @@
+package donation
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// DonationMsgTypeURLs returns the type URLs of the messages that donate
+// from the signer's own account, the ones a donation fee allowance covers
+func DonationMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgDonate{}),
+		sdk.MsgTypeURL(&MsgBatchDonate{}),
+	}
+}
+
+// NewDonationFeeAllowance returns a fee allowance a sponsor can grant with
+// x/feegrant so donors without gas tokens can still donate. It pays fees up
+// to spendLimit (unlimited if empty) until expiration (never if nil), and
+// only for transactions made up entirely of donation messages.
+func NewDonationFeeAllowance(spendLimit sdk.Coins, expiration *time.Time) (*feegrant.AllowedMsgAllowance, error) {
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+	if err := basic.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return feegrant.NewAllowedMsgAllowance(basic, DonationMsgTypeURLs())
+}
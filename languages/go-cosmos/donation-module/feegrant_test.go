@@ -0,0 +1,55 @@
+package donation
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDonationFeeAllowance(t *testing.T) {
+	donor := sdk.AccAddress("donor_______________")
+	expiration := time.Unix(1_800_000_000, 0).UTC()
+
+	allowance, err := NewDonationFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)), &expiration)
+	if err != nil {
+		t.Fatalf("NewDonationFeeAllowance: %v", err)
+	}
+
+	ctx := sdk.Context{}.
+		WithBlockTime(time.Unix(1_700_000_000, 0)).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	donate := &MsgDonate{Donor: donor.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5_000))}
+	send := banktypes.NewMsgSend(donor, donor, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+
+	tests := []struct {
+		name   string
+		msgs   []sdk.Msg
+		accept bool
+	}{
+		{"donation", []sdk.Msg{donate}, true},
+		{"batch donation", []sdk.Msg{&MsgBatchDonate{Donor: donor.String()}}, true},
+		{"other message", []sdk.Msg{send}, false},
+		{"donation bundled with another message", []sdk.Msg{donate, send}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := allowance.Accept(ctx, fee, tc.msgs)
+			if tc.accept && err != nil {
+				t.Fatalf("expected the fee to be granted, got %v", err)
+			}
+			if !tc.accept && err == nil {
+				t.Fatal("expected the fee to be refused")
+			}
+		})
+	}
+}
+
+func TestDonationFeeAllowanceRejectsInvalidLimit(t *testing.T) {
+	invalid := sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-1)}}
+	if _, err := NewDonationFeeAllowance(invalid, nil); err == nil {
+		t.Fatal("expected an invalid spend limit to be rejected")
+	}
+}
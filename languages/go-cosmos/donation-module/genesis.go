@@ -0,0 +1,201 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the donation module's genesis state
+type GenesisState struct {
+	Params Params
+	State  DonationState
+	Donors []DonorRecord
+
+	DonorAllowlist []string
+	DonorBlocklist []string
+
+	Beneficiaries []Beneficiary
+	Organizations []Organization
+
+	Delegations []ModuleDelegation
+	Unbondings  []ModuleUnbonding
+
+	VestingSchedules []VestingSchedule
+}
+
+// DefaultGenesis returns the default genesis state: default params and an
+// uninitialized module
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Donors: []DonorRecord{},
+	}
+}
+
+// Validate performs basic genesis state validation
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.State.Initialized {
+		if _, err := sdk.AccAddressFromBech32(gs.State.Admin); err != nil {
+			return fmt.Errorf("invalid admin: %w", err)
+		}
+		if len(gs.Params.DonationLimits) == 0 {
+			return fmt.Errorf("initialized state needs donation limits")
+		}
+	}
+
+	total := sdk.NewCoins()
+	seen := make(map[string]bool, len(gs.Donors))
+	for _, donor := range gs.Donors {
+		if seen[donor.Address] {
+			return fmt.Errorf("duplicate donor %s", donor.Address)
+		}
+		seen[donor.Address] = true
+
+		if !donor.TotalDonated.IsValid() {
+			return fmt.Errorf("invalid total for donor %s", donor.Address)
+		}
+		if !donor.Contribution.IsValid() {
+			return fmt.Errorf("invalid tier contribution for donor %s", donor.Address)
+		}
+		total = total.Add(donor.TotalDonated...)
+	}
+
+	for _, addr := range append(append([]string{}, gs.DonorAllowlist...), gs.DonorBlocklist...) {
+		if err := validateListAddress(addr); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateBeneficiaries(gs.Beneficiaries); err != nil {
+		return err
+	}
+
+	seenOrgs := make(map[string]bool, len(gs.Organizations))
+	for _, org := range gs.Organizations {
+		if err := org.Validate(); err != nil {
+			return err
+		}
+		if seenOrgs[org.Address] {
+			return fmt.Errorf("duplicate organization %s", org.Address)
+		}
+		seenOrgs[org.Address] = true
+	}
+
+	staked := sdk.ZeroInt()
+	for _, delegation := range gs.Delegations {
+		if _, err := sdk.ValAddressFromBech32(delegation.Validator); err != nil {
+			return fmt.Errorf("invalid delegation validator: %w", err)
+		}
+		if delegation.Amount.IsNil() || !delegation.Amount.IsPositive() {
+			return fmt.Errorf("delegation to %s must be positive", delegation.Validator)
+		}
+		staked = staked.Add(delegation.Amount)
+	}
+	for _, unbonding := range gs.Unbondings {
+		if _, err := sdk.ValAddressFromBech32(unbonding.Validator); err != nil {
+			return fmt.Errorf("invalid unbonding validator: %w", err)
+		}
+		if unbonding.Amount.IsNil() || !unbonding.Amount.IsPositive() {
+			return fmt.Errorf("unbonding from %s must be positive", unbonding.Validator)
+		}
+		staked = staked.Add(unbonding.Amount)
+	}
+	tracked := sdk.ZeroInt()
+	for _, coin := range gs.State.TotalStaked {
+		tracked = tracked.Add(coin.Amount)
+	}
+	if !staked.Equal(tracked) {
+		return fmt.Errorf("delegations and unbondings %s do not match total staked %s", staked, gs.State.TotalStaked)
+	}
+
+	locked := sdk.NewCoins()
+	seenVesting := make(map[string]bool, len(gs.VestingSchedules))
+	for _, schedule := range gs.VestingSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if seenVesting[schedule.Beneficiary] {
+			return fmt.Errorf("duplicate vesting schedule for %s", schedule.Beneficiary)
+		}
+		seenVesting[schedule.Beneficiary] = true
+		locked = locked.Add(schedule.Locked()...)
+	}
+	if !locked.IsAllGTE(gs.State.TotalVesting) || !gs.State.TotalVesting.IsAllGTE(locked) {
+		return fmt.Errorf("vesting schedules lock %s, total vesting is %s", locked, gs.State.TotalVesting)
+	}
+
+	// Staking rewards are credited to the donations but to no donor
+	donated, negative := gs.State.TotalDonations.SafeSub(gs.State.StakingRewards...)
+	if negative || !total.IsAllGTE(donated) || !donated.IsAllGTE(total) {
+		return fmt.Errorf("donor totals %s do not match total donations %s", total, gs.State.TotalDonations)
+	}
+
+	return nil
+}
+
+// InitGenesis initializes the module state from genesis
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	k.SetParams(ctx, gs.Params)
+
+	if gs.State.Initialized {
+		k.SetState(ctx, gs.State)
+	}
+
+	// SetDonor rebuilds the leaderboard, tier index and tier stats
+	for _, donor := range gs.Donors {
+		k.SetDonor(ctx, donor)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(DonorKeysLengthPrefixedKey, []byte{})
+	for _, addr := range gs.DonorAllowlist {
+		store.Set(GetDonorListKey(DonorListAllow, addr), []byte{})
+	}
+	for _, addr := range gs.DonorBlocklist {
+		store.Set(GetDonorListKey(DonorListBlock, addr), []byte{})
+	}
+
+	k.setBeneficiaries(ctx, gs.Beneficiaries)
+
+	for _, org := range gs.Organizations {
+		k.SetOrganization(ctx, org)
+	}
+
+	for _, delegation := range gs.Delegations {
+		k.setModuleDelegation(ctx, delegation)
+	}
+	for _, unbonding := range gs.Unbondings {
+		k.setModuleUnbonding(ctx, unbonding)
+	}
+
+	for _, schedule := range gs.VestingSchedules {
+		k.setVestingSchedule(ctx, schedule)
+	}
+}
+
+// ExportGenesis exports the module state
+func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
+	state, _ := k.GetState(ctx)
+
+	return &GenesisState{
+		Params: k.GetParams(ctx),
+		State:  state,
+		Donors: k.GetAllDonors(ctx),
+
+		DonorAllowlist: k.GetDonorListMembers(ctx, DonorListAllow),
+		DonorBlocklist: k.GetDonorListMembers(ctx, DonorListBlock),
+
+		Beneficiaries: k.GetBeneficiaries(ctx),
+		Organizations: k.GetAllOrganizations(ctx),
+
+		Delegations: k.GetAllModuleDelegations(ctx),
+		Unbondings:  k.GetAllModuleUnbondings(ctx),
+
+		VestingSchedules: k.GetAllVestingSchedules(ctx),
+	}
+}
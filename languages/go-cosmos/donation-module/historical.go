@@ -0,0 +1,67 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// checkQueryHeight checks that ctx reads the committed state at height.
+// State at a past height is served by the SDK's query-height mechanism:
+// baseapp opens that store version for a query sent with the
+// x-cosmos-block-height gRPC header (or RequestQuery.Height over ABCI)
+// before it reaches the keeper, which cannot branch to an older version
+// itself.
+func checkQueryHeight(ctx sdk.Context, height int64) error {
+	if height <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height %d out of range", height)
+	}
+	if ctx.BlockHeight() != height {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight,
+			"state at height %d must be queried at that height (%s header), got height %d",
+			height, grpctypes.GRPCBlockHeightHeader, ctx.BlockHeight())
+	}
+	return nil
+}
+
+// GetStateAt retrieves the donation state as of the given height; ctx must
+// be a query context at that height
+func (k Keeper) GetStateAt(ctx sdk.Context, height int64) (DonationState, bool, error) {
+	if err := checkQueryHeight(ctx, height); err != nil {
+		return DonationState{}, false, err
+	}
+
+	state, found := k.GetState(ctx)
+	return state, found, nil
+}
+
+// GetDonorAt retrieves a donor record as of the given height; ctx must be a
+// query context at that height. Store versions from before the consensus
+// version 4 upgrade keyed donors by prefix|address, so a record missing
+// under the current key is looked up under the legacy one unless the
+// version carries the migration's marker.
+func (k Keeper) GetDonorAt(ctx sdk.Context, addr string, height int64) (DonorRecord, bool, error) {
+	if err := checkQueryHeight(ctx, height); err != nil {
+		return DonorRecord{}, false, err
+	}
+
+	if donor, found := k.GetDonor(ctx, addr); found {
+		return donor, true, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(DonorKeysLengthPrefixedKey) {
+		return DonorRecord{}, false, nil
+	}
+
+	bz := store.Get(append(append([]byte{}, DonorKeyPrefix...), addr...))
+	if bz == nil {
+		return DonorRecord{}, false, nil
+	}
+
+	var donor DonorRecord
+	if err := k.cdc.Unmarshal(bz, &donor); err != nil {
+		return DonorRecord{}, false, err
+	}
+	return donor, true, nil
+}
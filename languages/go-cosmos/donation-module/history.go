@@ -0,0 +1,257 @@
+package donation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Donation is an append-only record of a single donation
+type Donation struct {
+	ID     uint64
+	Donor  string
+	Amount sdk.Coins
+	Height int64
+	Time   int64 // unix seconds
+	Memo   string
+	Fee    sdk.Coins // community-pool fee taken out of Amount
+	Burned sdk.Coins // burned out of Amount after the fee
+
+	Anonymous bool
+
+	// ReceiptID identifies the donation to off-chain systems, see
+	// DonationReceiptID. Empty for donations recorded before receipts.
+	ReceiptID string
+
+	// CampaignID is the campaign the donation was directed to, 0 for none
+	CampaignID uint64
+	// TeamID is the team whose total the donation was credited to, 0 for
+	// none
+	TeamID uint64
+	// USD is Amount's value at donation time, as credited toward the
+	// donor's USD total. Nil for donations recorded before it was kept.
+	USD sdk.Dec
+}
+
+// GetDonorDonationsPrefix returns the prefix of a donor's donation index.
+// The address is length-prefixed so one address is never a prefix of another.
+func GetDonorDonationsPrefix(donor string) []byte {
+	return append(DonorDonationIndexPrefix, address.MustLengthPrefix([]byte(donor))...)
+}
+
+// donationIndexes are the secondary indexes of the donations collection
+type donationIndexes struct {
+	// Donor indexes donations by donor address
+	Donor *indexes.Multi[string, uint64, Donation]
+	// Time indexes donations by block time in unix seconds
+	Time *indexes.Multi[uint64, uint64, Donation]
+}
+
+// IndexesList implements collections.Indexes
+func (i donationIndexes) IndexesList() []collections.Index[uint64, Donation] {
+	return []collections.Index[uint64, Donation]{i.Donor, i.Time}
+}
+
+func newDonationIndexes(sb *collections.SchemaBuilder) donationIndexes {
+	return donationIndexes{
+		Donor: indexes.NewMulti(
+			sb, collections.NewPrefix(DonorDonationIndexPrefix), "donations_by_donor",
+			donorAddressKey{}, collections.Uint64Key,
+			func(_ uint64, donation Donation) (string, error) {
+				return donation.Donor, nil
+			},
+		),
+		Time: indexes.NewMulti(
+			sb, collections.NewPrefix(DonationTimeIndexPrefix), "donations_by_time",
+			collections.Uint64Key, collections.Uint64Key,
+			func(_ uint64, donation Donation) (uint64, error) {
+				return uint64(donation.Time), nil
+			},
+		),
+	}
+}
+
+// recordDonation appends a donation record, indexed by donor and block
+// time, and counts it into the stats buckets. The ID, height, time and
+// receipt ID are assigned here.
+func (k Keeper) recordDonation(ctx sdk.Context, donation Donation) Donation {
+	donation.ID = k.nextDonationID(ctx)
+	donation.Height = ctx.BlockHeight()
+	donation.Time = ctx.BlockTime().Unix()
+	donation.ReceiptID = DonationReceiptID(donation.Donor, donation.ID, donation.Height)
+
+	k.setDonation(ctx, donation)
+	if err := k.receipts.Set(ctx, donation.ReceiptID, donation.ID); err != nil {
+		panic(err)
+	}
+	k.addToBuckets(ctx, donation.Time, donation.Amount)
+	incrDonationMetrics(donation.Amount)
+
+	return donation
+}
+
+// setDonation stores a donation record; the collection keeps the donor and
+// time indexes in sync
+func (k Keeper) setDonation(ctx sdk.Context, donation Donation) {
+	if err := k.donations.Set(ctx, donation.ID, donation); err != nil {
+		panic(err)
+	}
+}
+
+// removeDonation deletes a refunded donation from the history, its indexes
+// and the stats buckets, and forgets its receipt
+func (k Keeper) removeDonation(ctx sdk.Context, donation Donation) {
+	if err := k.donations.Remove(ctx, donation.ID); err != nil {
+		panic(err)
+	}
+	if donation.ReceiptID != "" {
+		if err := k.receipts.Remove(ctx, donation.ReceiptID); err != nil {
+			panic(err)
+		}
+	}
+	k.subFromBuckets(ctx, donation.Time, donation.Amount)
+}
+
+// GetDonation retrieves a donation record
+func (k Keeper) GetDonation(ctx sdk.Context, id uint64) (Donation, bool) {
+	donation, err := k.donations.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return Donation{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return donation, true
+}
+
+// DonationReceiptID returns the receipt ID of a donation: the hex SHA-256
+// of the length-prefixed donor address, the donation sequence number and
+// the block height. Any node computes the same ID, and no two donations
+// share one.
+func DonationReceiptID(donor string, sequence uint64, height int64) string {
+	h := sha256.New()
+	h.Write(address.MustLengthPrefix([]byte(donor)))
+	h.Write(sdk.Uint64ToBigEndian(sequence))
+	h.Write(sdk.Uint64ToBigEndian(uint64(height)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// GetDonationByReceipt returns the donation with the given receipt ID
+func (k Keeper) GetDonationByReceipt(ctx sdk.Context, receiptID string) (Donation, bool) {
+	id, err := k.receipts.Get(ctx, receiptID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return Donation{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return k.GetDonation(ctx, id)
+}
+
+// QueryDonationsByDonor returns a page of a donor's donations, oldest first
+func (k Keeper) QueryDonationsByDonor(
+	ctx sdk.Context,
+	donor string,
+	pageReq *query.PageRequest,
+) ([]Donation, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetDonorDonationsPrefix(donor))
+
+	donations := []Donation{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		if donation, found := k.GetDonation(ctx, sdk.BigEndianToUint64(key)); found {
+			donations = append(donations, donation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return donations, pageRes, nil
+}
+
+// QueryDonationsByTimeRange returns a page of the donations received with
+// block times in [start, end] (unix seconds), oldest first. Only the index
+// entries inside the range are read. Pages continue from NextKey; Offset
+// is honoured when no key is given, and totals are not counted.
+func (k Keeper) QueryDonationsByTimeRange(
+	ctx sdk.Context,
+	start, end int64,
+	pageReq *query.PageRequest,
+) ([]Donation, *query.PageResponse, error) {
+	if start < 0 || end < start {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid time range %d to %d", start, end)
+	}
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	keyCodec := k.donations.Indexes.Time.KeyCodec()
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		StartInclusive(collections.Join(uint64(start), uint64(0))).
+		EndExclusive(collections.Join(uint64(end)+1, uint64(0)))
+	if len(pageReq.Key) > 0 {
+		_, next, err := keyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid page key")
+		}
+		rng = rng.StartInclusive(next)
+	}
+
+	iterator, err := k.donations.Indexes.Time.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iterator.Close()
+
+	if len(pageReq.Key) == 0 {
+		for skipped := uint64(0); skipped < pageReq.Offset && iterator.Valid(); skipped++ {
+			iterator.Next()
+		}
+	}
+
+	donations := []Donation{}
+	for ; iterator.Valid(); iterator.Next() {
+		key, err := iterator.FullKey()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if uint64(len(donations)) == limit {
+			nextKey, err := collections.EncodeKeyWithPrefix(nil, keyCodec, key)
+			if err != nil {
+				return nil, nil, err
+			}
+			return donations, &query.PageResponse{NextKey: nextKey}, nil
+		}
+
+		if donation, found := k.GetDonation(ctx, key.K2()); found {
+			donations = append(donations, donation)
+		}
+	}
+
+	return donations, &query.PageResponse{}, nil
+}
+
+// nextDonationID returns the next donation ID and advances the sequence
+func (k Keeper) nextDonationID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(DonationSeqKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(DonationSeqKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
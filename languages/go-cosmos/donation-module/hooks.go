@@ -0,0 +1,85 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DonationHooks lets other modules react to donation events. A hook
+// returning an error aborts the operation that triggered it.
+type DonationHooks interface {
+	AfterDonation(ctx sdk.Context, donor string, amount sdk.Coins) error
+	AfterTierChange(ctx sdk.Context, donor string, previous, current DonorTier) error
+	AfterWithdrawal(ctx sdk.Context, recipient string, amount sdk.Coins) error
+}
+
+// MultiDonationHooks combines multiple hooks, called in order
+type MultiDonationHooks []DonationHooks
+
+var _ DonationHooks = MultiDonationHooks{}
+
+// NewMultiDonationHooks creates a MultiDonationHooks
+func NewMultiDonationHooks(hooks ...DonationHooks) MultiDonationHooks {
+	return hooks
+}
+
+// AfterDonation implements DonationHooks
+func (h MultiDonationHooks) AfterDonation(ctx sdk.Context, donor string, amount sdk.Coins) error {
+	for _, hook := range h {
+		if err := hook.AfterDonation(ctx, donor, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterTierChange implements DonationHooks
+func (h MultiDonationHooks) AfterTierChange(ctx sdk.Context, donor string, previous, current DonorTier) error {
+	for _, hook := range h {
+		if err := hook.AfterTierChange(ctx, donor, previous, current); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterWithdrawal implements DonationHooks
+func (h MultiDonationHooks) AfterWithdrawal(ctx sdk.Context, recipient string, amount sdk.Coins) error {
+	for _, hook := range h {
+		if err := hook.AfterWithdrawal(ctx, recipient, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetHooks sets the donation hooks. It must be called once, before the
+// keeper is copied into modules that depend on it.
+func (k *Keeper) SetHooks(hooks DonationHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set donation hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+func (k Keeper) afterDonation(ctx sdk.Context, donor string, amount sdk.Coins) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterDonation(ctx, donor, amount)
+}
+
+func (k Keeper) afterTierChange(ctx sdk.Context, donor string, previous, current DonorTier) error {
+	if k.hooks == nil || previous == current {
+		return nil
+	}
+	return k.hooks.AfterTierChange(ctx, donor, previous, current)
+}
+
+func (k Keeper) afterWithdrawal(ctx sdk.Context, recipient string, amount sdk.Coins) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterWithdrawal(ctx, recipient, amount)
+}
@@ -0,0 +1,76 @@
+package donation
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// AcceptedIBCDenom whitelists an IBC voucher by its trace, e.g. path
+// "transfer/channel-0" and base denom "uatom"
+type AcceptedIBCDenom struct {
+	Path      string
+	BaseDenom string
+}
+
+// isIBCDenom reports whether denom is an ICS-20 voucher denom (ibc/{hash})
+func isIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, transfertypes.DenomPrefix+"/")
+}
+
+// resolveIBCDenom returns the base denom behind an accepted IBC voucher.
+// ok is false for unknown traces and traces not listed in params.
+func (k Keeper) resolveIBCDenom(ctx sdk.Context, params Params, denom string) (baseDenom string, ok bool) {
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(denom, transfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return "", false
+	}
+
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", false
+	}
+
+	for _, accepted := range params.AcceptedIBCDenoms {
+		if accepted.Path == trace.Path && accepted.BaseDenom == trace.BaseDenom {
+			return trace.BaseDenom, true
+		}
+	}
+
+	return "", false
+}
+
+// validateDenoms rejects IBC vouchers that are not accepted in params
+func (k Keeper) validateDenoms(ctx sdk.Context, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range amount {
+		if !isIBCDenom(coin.Denom) {
+			continue
+		}
+		if _, ok := k.resolveIBCDenom(ctx, params, coin.Denom); !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "ibc denom %s is not accepted", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// tierCoins maps accepted IBC vouchers to their base denom so they count
+// toward tiers like the native asset; other denoms pass through unchanged
+func (k Keeper) tierCoins(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+	normalized := sdk.NewCoins()
+	for _, coin := range amount {
+		denom := coin.Denom
+		if isIBCDenom(denom) {
+			if base, ok := k.resolveIBCDenom(ctx, params, denom); ok {
+				denom = base
+			}
+		}
+		normalized = normalized.Add(sdk.NewCoin(denom, coin.Amount))
+	}
+
+	return normalized
+}
@@ -0,0 +1,258 @@
+package donation
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// DonationMemo is the ICS-20 memo that turns a transfer into a donation:
+//
+//	{"donation":{"campaign":1,"memo":"for the shelter"}}
+//
+// A zero or missing campaign makes a general donation. The optional memo is
+// stored with the donation record, and "anonymous":true makes an anonymous
+// donation.
+type DonationMemo struct {
+	Donation *DonationRoute `json:"donation"`
+}
+
+// DonationRoute is the donation instruction inside a DonationMemo
+type DonationRoute struct {
+	Campaign  uint64 `json:"campaign,omitempty"`
+	Memo      string `json:"memo,omitempty"`
+	Anonymous bool   `json:"anonymous,omitempty"`
+
+	// Referral is an optional referral code for off-chain attribution; the
+	// module does not read it
+	Referral string `json:"referral,omitempty"`
+}
+
+// parseDonationMemo returns the donation instruction in a transfer memo, or
+// nil if the memo does not request a donation
+func parseDonationMemo(memo string) (*DonationMemo, error) {
+	if !strings.Contains(memo, `"donation"`) {
+		return nil, nil
+	}
+
+	var parsed DonationMemo
+	if err := json.Unmarshal([]byte(memo), &parsed); err != nil {
+		return nil, err
+	}
+
+	if parsed.Donation == nil {
+		return nil, nil
+	}
+
+	return &parsed, nil
+}
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer module so that transfers carrying
+// a donation memo are received into the module account and credited to the
+// transfer's receiver as a donation. The foreign sender can't be the donor:
+// refunds and other payouts to donors need a local address.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer module
+func NewIBCMiddleware(app porttypes.IBCModule, k Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. Donation transfers are
+// redirected to the module account and recorded; any failure returns an
+// error acknowledgement, which reverts the receive and refunds the sender.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	memo, err := parseDonationMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid donation memo"))
+	}
+	if memo == nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// Reject before receiving, so the sender is refunded
+	if err := im.keeper.checkCircuit(ctx, sdk.MsgTypeURL(&MsgDonate{})); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", data.Amount))
+	}
+
+	// The receiver is the donor of record; it must be a local address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid donation receiver %s", data.Receiver))
+	}
+	donorAddr := receiver.String()
+
+	// A blocked sender can't donate through a local receiver
+	if im.keeper.IsListed(ctx, DonorListBlock, data.Sender) {
+		return channeltypes.NewErrorAcknowledgement(rejectDonation(RejectDonorBlocked,
+			sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "donor %s is blocked", data.Sender)))
+	}
+
+	// Receive the tokens into the module account instead of the receiver
+	data.Receiver = im.keeper.moduleAddress().String()
+	packet.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	// The transfer is acknowledged as received right away, so IBC donations
+	// skip the escrow period
+	donation := sdk.NewCoins(sdk.NewCoin(receivedDenom(packet, data.Denom), amount))
+	if memo.Donation.Campaign != 0 {
+		_, err = im.keeper.donateToCampaign(ctx, donorAddr, memo.Donation.Campaign, donation, memo.Donation.Memo, memo.Donation.Anonymous)
+	} else {
+		_, err = im.keeper.donate(ctx, donorAddr, donation, memo.Donation.Memo, memo.Donation.Anonymous)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	donor, _ := im.keeper.GetDonor(ctx, donorAddr)
+	if err := ctx.EventManager().EmitTypedEvent(&EventIBCDonationReceived{
+		Donor:         publicDonor(donor),
+		Amount:        donation,
+		CampaignID:    memo.Donation.Campaign,
+		SourceChannel: packet.GetSourceChannel(),
+		DestChannel:   packet.GetDestChannel(),
+	}); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// receivedDenom returns the local denom the transfer module credits for a
+// received packet denom, mirroring the ICS-20 receive logic
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// token is returning home: strip the prefix added by the sender chain
+		unprefixed := strings.TrimPrefix(denom, transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel()))
+		trace := transfertypes.ParseDenomTrace(unprefixed)
+		if trace.Path == "" {
+			return trace.BaseDenom
+		}
+		return trace.IBCDenom()
+	}
+
+	prefixed := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + denom
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Transfers
+// sent for forwarded donations move on to their next stage.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	// The transfer module has refunded a failed transfer by now
+	ackErr, err := acknowledgementError(acknowledgement)
+	if err != nil {
+		return err
+	}
+	im.keeper.onForwardTransferPacket(ctx, packet, ackErr)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	im.keeper.onForwardTransferPacket(ctx, packet, "packet timed out")
+	return nil
+}
@@ -0,0 +1,129 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = ICAControllerModule{}
+
+// ICAControllerModule is the application under the ICA controller
+// middleware for the module's interchain accounts. It only tracks the
+// acknowledgements of forwarded donations; the middleware handles the
+// channel handshake.
+type ICAControllerModule struct {
+	keeper Keeper
+}
+
+// NewICAControllerModule creates a new ICAControllerModule
+func NewICAControllerModule(k Keeper) ICAControllerModule {
+	return ICAControllerModule{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im ICAControllerModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface. Controller channels
+// are never opened by the counterparty.
+func (im ICAControllerModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "channel handshake must be initiated by the controller")
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im ICAControllerModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im ICAControllerModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "channel handshake must be initiated by the controller")
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im ICAControllerModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "interchain account channels can't be closed by the user")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im ICAControllerModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Controllers receive no
+// packets.
+func (im ICAControllerModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "interchain account controllers do not receive packets"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im ICAControllerModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	ackErr, err := acknowledgementError(acknowledgement)
+	if err != nil {
+		return err
+	}
+	im.keeper.onForwardTxPacket(ctx, packet, ackErr)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The controller
+// middleware has closed the ordered channel.
+func (im ICAControllerModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	im.keeper.onForwardTxPacket(ctx, packet, "packet timed out; the channel is closed")
+	return nil
+}
+
+// acknowledgementError returns the error of an error acknowledgement, or
+// "" for a successful one
+func acknowledgementError(acknowledgement []byte) (string, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal acknowledgement: %s", err)
+	}
+	if ack.Success() {
+		return "", nil
+	}
+	return ack.GetError(), nil
+}
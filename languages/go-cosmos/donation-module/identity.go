@@ -0,0 +1,95 @@
+package donation
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IdentityAttestation references a Verifiable Credential that was verified
+// off-chain for a donor. Only the credential hash is stored, never its content.
+type IdentityAttestation struct {
+	Donor          string
+	Issuer         string // issuer DID
+	CredentialHash string // hex sha256 of the presented credential
+	ExpiresAt      int64  // unix seconds, 0 if the credential does not expire
+	RecordedBy     string
+	RecordedAt     int64
+}
+
+// GetIdentityAttestationKey returns the store key for a donor's attestation
+func GetIdentityAttestationKey(donor string) []byte {
+	return append(IdentityAttestationKeyPrefix, []byte(donor)...)
+}
+
+// RecordIdentityAttestation stores the reference to a verified credential
+// for a donor, replacing any previous one. The attester needs the ATTESTER
+// role.
+func (k Keeper) RecordIdentityAttestation(
+	ctx sdk.Context,
+	attester string,
+	attestation IdentityAttestation,
+) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, attester, RoleAttester) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ATTESTER role required")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(attestation.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if attestation.Issuer == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issuer required")
+	}
+
+	if hash, err := hex.DecodeString(attestation.CredentialHash); err != nil || len(hash) != 32 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "credential hash must be a hex sha256 digest")
+	}
+
+	attestation.RecordedBy = attester
+	attestation.RecordedAt = ctx.BlockTime().Unix()
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&attestation)
+	store.Set(GetIdentityAttestationKey(attestation.Donor), bz)
+
+	if err := k.recordAudit(ctx, attester, AuditIdentityAttestation, map[string]interface{}{
+		"donor":           attestation.Donor,
+		"issuer":          attestation.Issuer,
+		"credential_hash": attestation.CredentialHash,
+		"expires_at":      attestation.ExpiresAt,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventIdentityAttested{
+		Donor:          attestation.Donor,
+		Issuer:         attestation.Issuer,
+		CredentialHash: attestation.CredentialHash,
+		ExpiresAt:      attestation.ExpiresAt,
+		Attester:       attester,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetIdentityAttestation retrieves a donor's identity attestation
+func (k Keeper) GetIdentityAttestation(ctx sdk.Context, donor string) (IdentityAttestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetIdentityAttestationKey(donor))
+	if bz == nil {
+		return IdentityAttestation{}, false
+	}
+
+	var attestation IdentityAttestation
+	k.cdc.MustUnmarshal(bz, &attestation)
+	return attestation, true
+}
@@ -0,0 +1,67 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the donation module invariants with the
+// crisis module
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(ModuleName, "donor-totals", DonorTotalsInvariant(k))
+}
+
+// AllInvariants runs all donation module invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, broken := ModuleBalanceInvariant(k)(ctx); broken {
+			return res, broken
+		}
+
+		return DonorTotalsInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds the tracked
+// funds: donations not yet withdrawn, the matching pool, challenge match
+// escrow, the reward pool with unclaimed rewards and donations held in
+// escrow. A surplus is tolerated rather than treated as drift, because the
+// module account must stay open to receive IBC donations and anyone can
+// send coins to it; QueryModuleAccount reports any surplus as untracked.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		info := k.QueryModuleAccount(ctx)
+		tracked := info.Withdrawable.Add(info.MatchingPool...).Add(info.Escrowed...).Add(info.Rewards...).Add(info.Held...)
+		broken := !info.Balances.IsAllGTE(tracked)
+
+		return sdk.FormatInvariant(ModuleName, "module-balance", fmt.Sprintf(
+			"\tmodule account balance: %s\n\ttracked (withdrawable + matching pool + escrow + rewards + held donations): %s\n",
+			info.Balances, tracked,
+		)), broken
+	}
+}
+
+// DonorTotalsInvariant checks that the donor records sum to TotalDonations,
+// less the staking rewards, which are credited to no donor
+func DonorTotalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		state, _ := k.GetState(ctx)
+
+		sum := sdk.NewCoins()
+		for _, donor := range k.GetAllDonors(ctx) {
+			sum = sum.Add(donor.TotalDonated...)
+		}
+
+		donated, negative := state.TotalDonations.SafeSub(state.StakingRewards...)
+
+		// Coins.IsEqual panics on mismatched denoms; compare both ways instead
+		broken := negative || !sum.IsAllGTE(donated) || !donated.IsAllGTE(sum)
+
+		return sdk.FormatInvariant(ModuleName, "donor-totals", fmt.Sprintf(
+			"\tsum of donor totals: %s\n\tTotalDonations: %s\n\tStakingRewards: %s\n",
+			sum, state.TotalDonations, state.StakingRewards,
+		)), broken
+	}
+}
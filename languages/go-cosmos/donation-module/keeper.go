@@ -1,31 +1,215 @@
 package donation
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Keeper handles donation module state
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
+	cdc                 codec.BinaryCodec
+	storeKey            storetypes.StoreKey
+	accountKeeper       AccountKeeper
+	bankKeeper          BankKeeper
+	distrKeeper         DistrKeeper
+	stakingKeeper       StakingKeeper
+	nftKeeper           NFTKeeper
+	transferKeeper      TransferKeeper
+	oracleKeeper        OracleKeeper        // optional, enables USD tiers
+	icaControllerKeeper ICAControllerKeeper // optional, enables forwarded donations
+	hooks               DonationHooks
+
+	// authority is the address allowed to execute governance-gated
+	// operations, typically the x/gov module account
+	authority string
+
+	// Collections over the keys below; the rest of the store is still
+	// accessed directly
+	schema        collections.Schema
+	state         collections.Item[DonationState]
+	params        collections.Item[Params]
+	pendingParams collections.Item[PendingParamsChange]
+	donors        *collections.IndexedMap[string, DonorRecord, donorIndexes]
+	donations     *collections.IndexedMap[uint64, Donation, donationIndexes]
+	disabledMsgs  collections.KeySet[string]      // Msg type URLs, see SetCircuitBreaker
+	receipts      collections.Map[string, uint64] // receipt ID to donation ID
+	snapshots     collections.Map[uint64, DonorSnapshot]
+	snapshotSeq   collections.Sequence
+	snapshotQueue collections.KeySet[collections.Pair[uint64, uint64]] // (height, snapshot ID) awaiting EndBlocker
+
+	rewardPool            collections.Item[RewardPool]
+	rewardDistributions   collections.Map[uint64, RewardDistribution]
+	rewardDistributionSeq collections.Sequence
+	rewardAllocations     collections.Map[collections.Pair[uint64, string], RewardAllocation] // (distribution ID, donor)
+	rewardExpiryQueue     collections.KeySet[collections.Pair[uint64, uint64]]                // (expiry time, distribution ID)
+
+	forwards       collections.Map[uint64, ForwardedDonation]
+	forwardSeq     collections.Sequence
+	forwardPackets collections.Map[collections.Pair[string, uint64], uint64] // (source channel, sequence) to forward ID
+
+	escrows     collections.Map[uint64, EscrowedDonation]
+	escrowSeq   collections.Sequence
+	escrowQueue collections.KeySet[collections.Pair[uint64, uint64]] // (release height, escrow ID)
+
+	campaignDeadlines     collections.KeySet[collections.Pair[uint64, uint64]]                    // (deadline, campaign ID)
+	campaignContributions collections.Map[collections.Pair[uint64, string], CampaignContribution] // (campaign ID, donor)
+	campaignPayoutQueue   collections.KeySet[collections.Pair[uint64, uint64]]                    // (payout height, campaign ID)
+
+	auditLog collections.Map[uint64, AuditEntry] // append-only, see recordAudit
+	auditSeq collections.Sequence
+
+	withdrawalTallies collections.Map[uint64, WithdrawalTally] // by block height, see trackWithdrawal
 }
 
-// NewKeeper creates a new donation Keeper
+// NewKeeper creates a new donation Keeper. The expected keepers are
+// interfaces, so unit tests can pass the gomock mocks in testutil instead
+// of a full app. It panics if the module account is not registered.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	accountKeeper AccountKeeper,
+	bankKeeper BankKeeper,
+	distrKeeper DistrKeeper,
+	stakingKeeper StakingKeeper,
+	nftKeeper NFTKeeper,
+	transferKeeper TransferKeeper,
+	oracleKeeper OracleKeeper,
+	icaControllerKeeper ICAControllerKeeper,
+	authority string,
 ) Keeper {
-	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+	if addr := accountKeeper.GetModuleAddress(ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", ModuleName))
 	}
+
+	sb := collections.NewSchemaBuilder(kvStoreService{key: storeKey})
+	k := Keeper{
+		cdc:                 cdc,
+		storeKey:            storeKey,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		distrKeeper:         distrKeeper,
+		stakingKeeper:       stakingKeeper,
+		nftKeeper:           nftKeeper,
+		transferKeeper:      transferKeeper,
+		oracleKeeper:        oracleKeeper,
+		icaControllerKeeper: icaControllerKeeper,
+		authority:           authority,
+
+		state:         collections.NewItem(sb, collections.NewPrefix(StateKey), "state", newProtoValue[DonationState](cdc)),
+		params:        collections.NewItem(sb, collections.NewPrefix(ParamsKey), "params", newProtoValue[Params](cdc)),
+		pendingParams: collections.NewItem(sb, collections.NewPrefix(PendingParamsKey), "pending_params", newProtoValue[PendingParamsChange](cdc)),
+		donors: collections.NewIndexedMap(
+			sb, collections.NewPrefix(DonorKeyPrefix), "donors",
+			donorAddressKey{}, newProtoValue[DonorRecord](cdc),
+			newDonorIndexes(sb),
+		),
+		donations: collections.NewIndexedMap(
+			sb, collections.NewPrefix(DonationKeyPrefix), "donations",
+			collections.Uint64Key, newProtoValue[Donation](cdc),
+			newDonationIndexes(sb),
+		),
+		disabledMsgs: collections.NewKeySet(sb, collections.NewPrefix(DisabledMsgKeyPrefix), "disabled_msgs", collections.StringKey),
+		receipts:     collections.NewMap(sb, collections.NewPrefix(ReceiptKeyPrefix), "receipts", collections.StringKey, collections.Uint64Value),
+		snapshots: collections.NewMap(
+			sb, collections.NewPrefix(DonorSnapshotKeyPrefix), "donor_snapshots",
+			collections.Uint64Key, newProtoValue[DonorSnapshot](cdc),
+		),
+		snapshotSeq: collections.NewSequence(sb, collections.NewPrefix(DonorSnapshotSeqKey), "donor_snapshot_seq"),
+		snapshotQueue: collections.NewKeySet(
+			sb, collections.NewPrefix(DonorSnapshotQueuePrefix), "donor_snapshot_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+
+		rewardPool: collections.NewItem(sb, collections.NewPrefix(RewardPoolKey), "reward_pool", newProtoValue[RewardPool](cdc)),
+		rewardDistributions: collections.NewMap(
+			sb, collections.NewPrefix(RewardDistributionKeyPrefix), "reward_distributions",
+			collections.Uint64Key, newProtoValue[RewardDistribution](cdc),
+		),
+		rewardDistributionSeq: collections.NewSequence(sb, collections.NewPrefix(RewardDistributionSeqKey), "reward_distribution_seq"),
+		rewardAllocations: collections.NewMap(
+			sb, collections.NewPrefix(RewardAllocationKeyPrefix), "reward_allocations",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), newProtoValue[RewardAllocation](cdc),
+		),
+		rewardExpiryQueue: collections.NewKeySet(
+			sb, collections.NewPrefix(RewardExpiryQueuePrefix), "reward_expiry_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+
+		forwards: collections.NewMap(
+			sb, collections.NewPrefix(ForwardedDonationKeyPrefix), "forwarded_donations",
+			collections.Uint64Key, newProtoValue[ForwardedDonation](cdc),
+		),
+		forwardSeq: collections.NewSequence(sb, collections.NewPrefix(ForwardedDonationSeqKey), "forwarded_donation_seq"),
+		forwardPackets: collections.NewMap(
+			sb, collections.NewPrefix(ForwardPacketKeyPrefix), "forward_packets",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Uint64Value,
+		),
+
+		escrows: collections.NewMap(
+			sb, collections.NewPrefix(EscrowedDonationKeyPrefix), "escrowed_donations",
+			collections.Uint64Key, newProtoValue[EscrowedDonation](cdc),
+		),
+		escrowSeq: collections.NewSequence(sb, collections.NewPrefix(EscrowedDonationSeqKey), "escrowed_donation_seq"),
+		escrowQueue: collections.NewKeySet(
+			sb, collections.NewPrefix(EscrowReleaseQueuePrefix), "escrow_release_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		campaignDeadlines: collections.NewKeySet(
+			sb, collections.NewPrefix(CampaignDeadlineQueuePrefix), "campaign_deadline_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		campaignContributions: collections.NewMap(
+			sb, collections.NewPrefix(CampaignContributionKeyPrefix), "campaign_contributions",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), newProtoValue[CampaignContribution](cdc),
+		),
+		campaignPayoutQueue: collections.NewKeySet(
+			sb, collections.NewPrefix(CampaignPayoutQueuePrefix), "campaign_payout_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		auditLog: collections.NewMap(
+			sb, collections.NewPrefix(AuditLogKeyPrefix), "audit_log",
+			collections.Uint64Key, newProtoValue[AuditEntry](cdc),
+		),
+		auditSeq: collections.NewSequence(sb, collections.NewPrefix(AuditLogSeqKey), "audit_log_seq"),
+		withdrawalTallies: collections.NewMap(
+			sb, collections.NewPrefix(WithdrawalTallyKeyPrefix), "withdrawal_tallies",
+			collections.Uint64Key, newProtoValue[WithdrawalTally](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.schema = schema
+
+	return k
+}
+
+// moduleAddress returns the address of the module account
+func (k Keeper) moduleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(ModuleName)
+}
+
+// GetAuthority returns the module's governance authority address
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
+// ModuleName is the name of the donation module and its module account
+const ModuleName = "donation"
+
+// TierDenom is the unit tier thresholds and leaderboard scores are in
+const TierDenom = "uatom"
+
 // DonorTier represents donor tier levels
 type DonorTier uint8
 
@@ -40,12 +224,34 @@ const (
 // DonationState stores the contract state
 type DonationState struct {
 	Admin          string
+	PendingAdmin   string
 	TotalDonations sdk.Coins
+	TotalWithdrawn sdk.Coins
+	TotalBurned    sdk.Coins // burned at Params.BurnRate, never withdrawable
+	TotalFees      sdk.Coins // community-pool fees, never withdrawable
+	TotalStaked    sdk.Coins // delegated or unbonding, not withdrawable until released
+	TotalSlashed   sdk.Coins // staked funds lost to slashing
+	StakingRewards sdk.Coins // claimed rewards, included in TotalDonations
+	TotalVesting   sdk.Coins // reserved by vesting schedules, not yet released
+	HeldDonations  sdk.Coins // in escrow for their clawback window, not in TotalDonations
 	DonorCount     uint64
-	MinDonation    sdk.Coins
-	MaxDonation    sdk.Coins
 	Paused         bool
 	Initialized    bool
+
+	// PauseReason says why donations are paused, for operators and UIs
+	PauseReason string
+	// UnpauseHeight, if non-zero, is the block at whose start BeginBlocker
+	// resumes donations
+	UnpauseHeight int64
+
+	// AllowlistMode accepts donations only from allowlisted donors
+	AllowlistMode bool
+
+	// Deprecated: replaced by Params.DonationLimits; only read by the
+	// version 1 to 2 store migration
+	MinDonation sdk.Coins
+	// Deprecated: replaced by Params.DonationLimits
+	MaxDonation sdk.Coins
 }
 
 // DonorRecord stores donor information
@@ -54,17 +260,123 @@ type DonorRecord struct {
 	TotalDonated  sdk.Coins
 	Tier          DonorTier
 	FirstDonation int64
+	TotalUSD      sdk.Dec // lifetime total valued at donation-time prices
+
+	// Anonymous hides the address from events and public queries; set by
+	// the donor's first anonymous donation and kept from then on
+	Anonymous bool
+
+	// Contribution counts toward the tier as of LastDonation; it tracks the
+	// totals above, less any tier decay applied at each donation
+	Contribution    sdk.Coins
+	ContributionUSD sdk.Dec
+	LastDonation    int64 // unix seconds
+
+	// Loyalty: the current run of consecutive epochs with a donation, the
+	// epoch it was last extended in, and the points accrued
+	Streak        uint64
+	LongestStreak uint64
+	StreakEpoch   int64
+	LoyaltyPoints uint64
 }
 
 // Keys for store
 var (
-	StateKey       = []byte{0x01}
-	DonorKeyPrefix = []byte{0x02}
+	StateKey        = []byte{0x01}
+	DonorKeyPrefix  = []byte{0x02}
+	MatchingPoolKey = []byte{0x03}
+
+	CampaignKeyPrefix       = []byte{0x04}
+	CampaignSeqKey          = []byte{0x05}
+	ActiveCampaignKeyPrefix = []byte{0x06}
+	RoleKeyPrefix           = []byte{0x07}
+
+	ParamsKey                     = []byte{0x08}
+	PendingEmergencyWithdrawalKey = []byte{0x09}
+	IdentityAttestationKeyPrefix  = []byte{0x0A}
+	DonorSetAnchorKeyPrefix       = []byte{0x0B}
+
+	DonationKeyPrefix        = []byte{0x0C}
+	DonorDonationIndexPrefix = []byte{0x0D}
+	DonationSeqKey           = []byte{0x0E}
+	PendingParamsKey         = []byte{0x0F}
+
+	LeaderboardKeyPrefix         = []byte{0x10}
+	LeaderboardPositionKeyPrefix = []byte{0x11}
+
+	TeamKeyPrefix                    = []byte{0x12}
+	TeamSeqKey                       = []byte{0x13}
+	TeamMembershipKeyPrefix          = []byte{0x14}
+	TeamMemberKeyPrefix              = []byte{0x15}
+	TeamLeaderboardKeyPrefix         = []byte{0x16}
+	TeamLeaderboardPositionKeyPrefix = []byte{0x17}
+	TierIndexKeyPrefix               = []byte{0x18}
+	CampaignUpdateKeyPrefix          = []byte{0x19}
+	DonorListKeyPrefix               = []byte{0x1A}
+	BeneficiariesKey                 = []byte{0x1B}
+	CampaignExtensionQueuePrefix     = []byte{0x1C}
+	OrganizationKeyPrefix            = []byte{0x1D}
+	ModuleDelegationKeyPrefix        = []byte{0x1E}
+	ModuleUnbondingQueuePrefix       = []byte{0x1F}
+	BoostKeyPrefix                   = []byte{0x20}
+	BoostSeqKey                      = []byte{0x21}
+	BoostExpiryQueuePrefix           = []byte{0x22}
+	VestingScheduleKeyPrefix         = []byte{0x23}
+	ChallengeMatchKeyPrefix          = []byte{0x24}
+	ChallengeMatchSeqKey             = []byte{0x25}
+	PendingChallengeKeyPrefix        = []byte{0x26}
+	TierStatsKeyPrefix               = []byte{0x27}
+	DonationStatsKeyPrefix           = []byte{0x28}
+	DonationTimeIndexPrefix          = []byte{0x29}
+	DisabledMsgKeyPrefix             = []byte{0x2A}
+	ReceiptKeyPrefix                 = []byte{0x2B}
+	DonorSnapshotKeyPrefix           = []byte{0x2C}
+	DonorSnapshotSeqKey              = []byte{0x2D}
+	DonorSnapshotQueuePrefix         = []byte{0x2E}
+	RewardPoolKey                    = []byte{0x2F}
+	RewardDistributionKeyPrefix      = []byte{0x30}
+	RewardDistributionSeqKey         = []byte{0x31}
+	RewardAllocationKeyPrefix        = []byte{0x32}
+	RewardExpiryQueuePrefix          = []byte{0x33}
+	ForwardedDonationKeyPrefix       = []byte{0x34}
+	ForwardedDonationSeqKey          = []byte{0x35}
+	ForwardPacketKeyPrefix           = []byte{0x36}
+	EscrowedDonationKeyPrefix        = []byte{0x37}
+	EscrowedDonationSeqKey           = []byte{0x38}
+	EscrowReleaseQueuePrefix         = []byte{0x39}
+	CampaignDeadlineQueuePrefix      = []byte{0x3A}
+	CampaignContributionKeyPrefix    = []byte{0x3B}
+	CampaignPayoutQueuePrefix        = []byte{0x3C}
+	AuditLogKeyPrefix                = []byte{0x3D}
+	AuditLogSeqKey                   = []byte{0x3E}
+	DonorKeysLengthPrefixedKey       = []byte{0x3F}
+	WithdrawalTallyKeyPrefix         = []byte{0x40}
 )
 
-// GetDonorKey returns the store key for a donor
+// Withdrawable returns the donations held liquid in the module account:
+// not withdrawn, burned, paid as fees, staked, slashed or reserved for
+// vesting
+func (s DonationState) Withdrawable() sdk.Coins {
+	out := s.TotalWithdrawn.Add(s.TotalBurned...).Add(s.TotalFees...).Add(s.TotalStaked...).Add(s.TotalSlashed...).Add(s.TotalVesting...)
+	withdrawable, _ := s.TotalDonations.SafeSub(out...)
+	return positiveCoins(withdrawable)
+}
+
+// positiveCoins drops non-positive entries left by a SafeSub
+func positiveCoins(coins sdk.Coins) sdk.Coins {
+	positive := sdk.NewCoins()
+	for _, coin := range coins {
+		if coin.IsPositive() {
+			positive = positive.Add(coin)
+		}
+	}
+	return positive
+}
+
+// GetDonorKey returns the store key for a donor. The address is
+// length-prefixed so one address is never a prefix of another.
 func GetDonorKey(addr string) []byte {
-	return append(DonorKeyPrefix, []byte(addr)...)
+	return append(DonorKeyPrefix, address.MustLengthPrefix([]byte(addr))...)
 }
 
 // Initialize initializes the donation module
@@ -79,66 +391,139 @@ func (k Keeper) Initialize(
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "already initialized")
 	}
 
-	if minDonation.IsZero() || !minDonation.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid min donation")
+	if minDonation.IsZero() || !minDonation.IsValid() || !maxDonation.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid donation limits")
 	}
 
-	if !maxDonation.IsValid() || !maxDonation.IsAllGT(minDonation) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "max must be greater than min")
+	limits, err := DonationLimitsFromCoins(minDonation, maxDonation)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
 	}
 
 	newState := DonationState{
 		Admin:          admin,
 		TotalDonations: sdk.NewCoins(),
+		TotalWithdrawn: sdk.NewCoins(),
+		TotalBurned:    sdk.NewCoins(),
+		TotalFees:      sdk.NewCoins(),
+		TotalStaked:    sdk.NewCoins(),
+		TotalSlashed:   sdk.NewCoins(),
+		StakingRewards: sdk.NewCoins(),
+		TotalVesting:   sdk.NewCoins(),
+		HeldDonations:  sdk.NewCoins(),
 		DonorCount:     0,
-		MinDonation:    minDonation,
-		MaxDonation:    maxDonation,
 		Paused:         false,
 		Initialized:    true,
 	}
 
+	// Seed the per-denom limits; later changes go through governance
+	params := k.GetParams(ctx)
+	params.DonationLimits = limits
+
+	k.SetParams(ctx, params)
 	k.SetState(ctx, newState)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"donation_initialized",
-			sdk.NewAttribute("admin", admin),
-			sdk.NewAttribute("min_donation", minDonation.String()),
-			sdk.NewAttribute("max_donation", maxDonation.String()),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&EventInitialized{
+		Admin:       admin,
+		MinDonation: minDonation,
+		MaxDonation: maxDonation,
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-// Donate processes a donation
+// Donate processes a donation. memo is optional UTF-8 text, at most
+// Params.MaxMemoLength bytes, stored with the donation record. An anonymous
+// donation still counts toward the donor's tier, but the donor's address is
+// left out of events and public queries. With an escrow period set, the
+// donation is held in escrow instead; see EscrowDonation.
 func (k Keeper) Donate(
 	ctx sdk.Context,
 	donor string,
 	amount sdk.Coins,
+	memo string,
+	anonymous bool,
 ) error {
+	if k.escrowEnabled(ctx) {
+		_, err := k.EscrowDonation(ctx, donor, 0, amount, memo, anonymous)
+		return err
+	}
+
+	_, err := k.donate(ctx, donor, amount, memo, anonymous)
+	return err
+}
+
+// donate processes a donation immediately, bypassing any escrow period, and
+// returns its record, which carries the receipt ID
+func (k Keeper) donate(
+	ctx sdk.Context,
+	donor string,
+	amount sdk.Coins,
+	memo string,
+	anonymous bool,
+) (Donation, error) {
+	if err := k.checkDonation(ctx, donor, amount, memo); err != nil {
+		return Donation{}, err
+	}
+	return k.creditDonation(ctx, donor, amount, memo, anonymous)
+}
+
+// checkDonation rejects a donation the module can't accept now
+func (k Keeper) checkDonation(ctx sdk.Context, donor string, amount sdk.Coins, memo string) error {
 	state, found := k.GetState(ctx)
 	if !found || !state.Initialized {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+		return rejectDonation(RejectNotInitialized, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized"))
 	}
 
 	if state.Paused {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract is paused")
+		return rejectDonation(RejectPaused, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract is paused"))
+	}
+
+	if reason, err := k.checkDonorLists(ctx, state, donor); err != nil {
+		return rejectDonation(reason, err)
 	}
 
 	// Validate donation amount
 	if !amount.IsValid() || amount.IsZero() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid donation amount")
+		return rejectDonation(RejectInvalidAmount, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid donation amount"))
+	}
+
+	if err := k.validateDenoms(ctx, amount); err != nil {
+		return rejectDonation(RejectDenomNotAllowed, err)
+	}
+
+	if err := k.checkDust(ctx, amount); err != nil {
+		return rejectDonation(RejectDust, err)
+	}
+
+	if reason, err := k.checkDonationLimits(ctx, amount); err != nil {
+		return rejectDonation(reason, err)
 	}
 
-	if !amount.IsAllGTE(state.MinDonation) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "donation too small")
+	if reason, err := k.checkDonorRateLimit(ctx, donor, amount); err != nil {
+		return rejectDonation(reason, err)
 	}
 
-	if !state.MaxDonation.IsAllGTE(amount) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "donation too large")
+	if err := k.GetParams(ctx).ValidateMemo(memo); err != nil {
+		return rejectDonation(RejectInvalidMemo, err)
 	}
 
+	return nil
+}
+
+// creditDonation records an accepted donation: it counts toward the donor's
+// total and tier and the module's TotalDonations
+func (k Keeper) creditDonation(
+	ctx sdk.Context,
+	donor string,
+	amount sdk.Coins,
+	memo string,
+	anonymous bool,
+) (Donation, error) {
+	state, _ := k.GetState(ctx)
+
 	// Get or create donor record
 	donorRecord, found := k.GetDonor(ctx, donor)
 	if !found {
@@ -147,37 +532,99 @@ func (k Keeper) Donate(
 			TotalDonated:  sdk.NewCoins(),
 			Tier:          TierNone,
 			FirstDonation: ctx.BlockTime().Unix(),
+			TotalUSD:      sdk.ZeroDec(),
 		}
 		state.DonorCount++
 	}
 
+	// Match from the sponsor pool, credited toward the donor's tier
+	matched := k.applyMatch(ctx, amount)
+	credited := amount.Add(matched...)
+
 	// Update donor record
-	donorRecord.TotalDonated = donorRecord.TotalDonated.Add(amount...)
-	donorRecord.Tier = k.CalculateTier(donorRecord.TotalDonated)
+	previousTier := donorRecord.Tier
+	donorRecord.Anonymous = donorRecord.Anonymous || anonymous
+	usd := k.usdValue(ctx, credited)
+	donorRecord.TotalDonated = donorRecord.TotalDonated.Add(credited...)
+	donorRecord.TotalUSD = addUSD(donorRecord.TotalUSD, usd)
+	k.addContribution(ctx, &donorRecord, credited, usd)
+	donorRecord.Tier = k.donorTier(ctx, donorRecord)
+	if err := k.updateStreak(ctx, &donorRecord); err != nil {
+		return Donation{}, err
+	}
+
+	// Mint or upgrade the donor's badge NFT on tier change
+	if donorRecord.Tier != previousTier {
+		if err := k.updateBadge(ctx, donorRecord); err != nil {
+			return Donation{}, err
+		}
+	}
 
 	// Update state
-	state.TotalDonations = state.TotalDonations.Add(amount...)
+	state.TotalDonations = state.TotalDonations.Add(credited...)
+
+	// The fee comes off the top; the burn applies to the net donation
+	fee, err := k.chargeCommunityPoolFee(ctx, amount)
+	if err != nil {
+		return Donation{}, err
+	}
+	state.TotalFees = state.TotalFees.Add(fee...)
+
+	burned, err := k.burnDonation(ctx, amount.Sub(fee...))
+	if err != nil {
+		return Donation{}, err
+	}
+	state.TotalBurned = state.TotalBurned.Add(burned...)
 
 	// Save updates
 	k.SetDonor(ctx, donorRecord)
 	k.SetState(ctx, state)
+	donationUSD := usd
+	if !matched.IsZero() {
+		donationUSD = k.usdValue(ctx, amount)
+	}
+	donation := k.recordDonation(ctx, Donation{
+		Donor:     donor,
+		Amount:    amount,
+		Memo:      memo,
+		Fee:       fee,
+		Burned:    burned,
+		Anonymous: donorRecord.Anonymous,
+		TeamID:    k.creditTeam(ctx, donor, 0, amount),
+		USD:       donationUSD,
+	})
 
 	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"donation_received",
-			sdk.NewAttribute("donor", donor),
-			sdk.NewAttribute("amount", amount.String()),
-			sdk.NewAttribute("total", donorRecord.TotalDonated.String()),
-			sdk.NewAttribute("tier", fmt.Sprintf("%d", donorRecord.Tier)),
-			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", ctx.BlockTime().Unix())),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&EventDonationReceived{
+		Donor:     publicDonor(donorRecord),
+		Amount:    amount,
+		Matched:   matched,
+		Total:     donorRecord.TotalDonated,
+		Tier:      donorRecord.Tier,
+		Timestamp: ctx.BlockTime().Unix(),
+		USDValue:  usd,
+		Memo:      memo,
+		Burned:    burned,
+		Fee:       fee,
+		ReceiptID: donation.ReceiptID,
+	}); err != nil {
+		return Donation{}, err
+	}
 
-	return nil
+	if err := k.afterDonation(ctx, donor, amount); err != nil {
+		return Donation{}, err
+	}
+
+	if err := k.afterTierChange(ctx, donor, previousTier, donorRecord.Tier); err != nil {
+		return Donation{}, err
+	}
+
+	return donation, nil
 }
 
-// Withdraw allows admin to withdraw funds
+// Withdraw withdraws funds. Depending on the amount's withdrawal band the
+// sender must be a WITHDRAWER, the treasury multisig or governance. An
+// empty recipient splits the amount across the registered beneficiaries.
 func (k Keeper) Withdraw(
 	ctx sdk.Context,
 	admin string,
@@ -189,29 +636,75 @@ func (k Keeper) Withdraw(
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
 	}
 
-	if admin != state.Admin {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can withdraw")
-	}
-
 	if !amount.IsValid() || amount.IsZero() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid withdrawal amount")
 	}
 
+	band, err := k.checkWithdrawalApproval(ctx, admin, amount)
+	if err != nil {
+		return err
+	}
+
+	if !state.Withdrawable().IsAllGTE(amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "withdrawal exceeds withdrawable balance; staked funds must be undelegated first")
+	}
+
+	// An empty recipient splits the withdrawal across the beneficiaries
+	if recipient == "" {
+		if err := k.payBeneficiaries(ctx, amount); err != nil {
+			return err
+		}
+	} else {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		if err := k.checkStrictRecipient(ctx, recipient); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, recipientAddr, amount); err != nil {
+			return err
+		}
+	}
+
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(amount...)
+	k.SetState(ctx, state)
+	k.trackWithdrawal(ctx, amount)
+	incrWithdrawalMetrics("standard")
+
+	action := AuditWithdraw
+	if recipient == "" {
+		action = AuditDistribute
+	}
+	if err := k.recordAudit(ctx, admin, action, map[string]interface{}{
+		"amount":    amount.String(),
+		"recipient": recipient,
+	}); err != nil {
+		return err
+	}
+
 	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"withdrawal",
-			sdk.NewAttribute("admin", admin),
-			sdk.NewAttribute("amount", amount.String()),
-			sdk.NewAttribute("recipient", recipient),
-			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", ctx.BlockTime().Unix())),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&EventWithdrawal{
+		Admin:     admin,
+		Amount:    amount,
+		Recipient: recipient,
+		Band:      string(band),
+		Timestamp: ctx.BlockTime().Unix(),
+	}); err != nil {
+		return err
+	}
 
-	return nil
+	if recipient == "" {
+		return nil
+	}
+	return k.afterWithdrawal(ctx, recipient, amount)
 }
 
-// EmergencyWithdraw allows admin to withdraw all funds
+// EmergencyWithdraw allows a WITHDRAWER to withdraw all withdrawable funds
+// once a withdrawal scheduled via ScheduleEmergencyWithdraw has passed its
+// timelock. Matching pool funds stay in place.
 func (k Keeper) EmergencyWithdraw(
 	ctx sdk.Context,
 	admin string,
@@ -222,51 +715,246 @@ func (k Keeper) EmergencyWithdraw(
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
 	}
 
-	if admin != state.Admin {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can withdraw")
+	if !k.HasRole(ctx, admin, RoleWithdrawer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "WITHDRAWER role required")
 	}
 
-	balance := state.TotalDonations
+	pending, found := k.GetPendingEmergencyWithdrawal(ctx)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "emergency withdrawal not scheduled")
+	}
+
+	if ctx.BlockHeight() < pending.ExecutableHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "timelocked until height %d", pending.ExecutableHeight)
+	}
+
+	if recipient != pending.Recipient {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "recipient does not match scheduled recipient %s", pending.Recipient)
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PendingEmergencyWithdrawalKey)
+
+	balance := state.Withdrawable()
+	if !balance.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, recipientAddr, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(balance...)
+	k.SetState(ctx, state)
+	incrWithdrawalMetrics("emergency")
+
+	if err := k.recordAudit(ctx, admin, AuditEmergencyWithdraw, map[string]interface{}{
+		"amount":    balance.String(),
+		"recipient": recipient,
+	}); err != nil {
+		return nil, err
+	}
 
 	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"emergency_withdrawal",
-			sdk.NewAttribute("admin", admin),
-			sdk.NewAttribute("amount", balance.String()),
-			sdk.NewAttribute("recipient", recipient),
-			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", ctx.BlockTime().Unix())),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&EventEmergencyWithdrawal{
+		Admin:     admin,
+		Amount:    balance,
+		Recipient: recipient,
+		Timestamp: ctx.BlockTime().Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.afterWithdrawal(ctx, recipient, balance); err != nil {
+		return nil, err
+	}
 
 	return balance, nil
 }
 
-// Pause pauses the contract
-func (k Keeper) Pause(ctx sdk.Context, admin string) error {
+// Refund returns a donation to the donor, e.g. one sent by mistake, and
+// reverses its accounting: the donor's totals at the USD value it was
+// credited at, the campaign and team it counted toward, the stats buckets
+// and the donation history. The community-pool fee and the burned share
+// have already left the module account, so the donor gets back what the
+// module still holds of it, Amount less Fee and Burned, and both are taken
+// back out of TotalFees and TotalBurned along with the donation. The refund
+// needs the same approval as a withdrawal of that amount. Any sponsor match
+// it drew stays credited to the donor. A donation to a campaign that has
+// been finalized is refunded through ClaimCampaignRefund instead.
+func (k Keeper) Refund(ctx sdk.Context, sender string, donationID uint64) (sdk.Coins, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	donation, found := k.GetDonation(ctx, donationID)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donation %d not found", donationID)
+	}
+
+	donorRecord, found := k.GetDonor(ctx, donation.Donor)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "donor not found")
+	}
+
+	amount := donation.Amount
+	if !donorRecord.TotalDonated.IsAllGTE(amount) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "refund exceeds donated amount")
+	}
+
+	campaign, campaignFound := k.GetCampaign(ctx, donation.CampaignID)
+	if donation.CampaignID != 0 {
+		if campaignFound && campaign.Status != CampaignActive {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d has been finalized; use ClaimCampaignRefund", donation.CampaignID)
+		}
+	}
+
+	refund, negative := amount.SafeSub(donation.Fee.Add(donation.Burned...)...)
+	if negative {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "donation %d has nothing left to refund", donationID)
+	}
+
+	band, err := k.checkWithdrawalApproval(ctx, sender, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	// Funds already withdrawn cannot be refunded
+	if !state.Withdrawable().IsAllGTE(refund) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "refund exceeds withdrawable balance")
+	}
+
+	donorAddr, err := sdk.AccAddressFromBech32(donation.Donor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, donorAddr, refund); err != nil {
+			return nil, err
+		}
+		k.trackWithdrawal(ctx, refund)
+	}
+
+	usd := donation.USD
+	if usd.IsNil() {
+		usd = k.usdValue(ctx, amount)
+	}
+	state.TotalFees = state.TotalFees.Sub(donation.Fee...)
+	state.TotalBurned = state.TotalBurned.Sub(donation.Burned...)
+	previousTier := k.debitDonor(ctx, &state, &donorRecord, amount, usd)
+
+	if donation.CampaignID != 0 && campaignFound {
+		raised, _ := campaign.Raised.SafeSub(amount...)
+		campaign.Raised = positiveCoins(raised)
+		k.SetCampaign(ctx, campaign)
+		k.subCampaignContribution(ctx, donation.Donor, donation.CampaignID, amount, usd)
+	}
+	if donation.TeamID != 0 {
+		k.debitTeam(ctx, donation.TeamID, amount)
+	}
+	k.removeDonation(ctx, donation)
+
+	if err := k.recordAudit(ctx, sender, AuditRefund, map[string]interface{}{
+		"donation_id": donationID,
+		"amount":      refund.String(),
+		"donor":       donation.Donor,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventDonationRefunded{
+		Admin:      sender,
+		Donor:      publicDonor(donorRecord),
+		Amount:     refund,
+		Total:      donorRecord.TotalDonated,
+		Tier:       donorRecord.Tier,
+		Timestamp:  ctx.BlockTime().Unix(),
+		DonationID: donationID,
+		Band:       string(band),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.afterTierChange(ctx, donation.Donor, previousTier, donorRecord.Tier); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// debitDonor takes a refunded amount, worth refundUSD when it was credited,
+// off the donor's record and the donation totals, and returns the donor's
+// tier before the refund
+func (k Keeper) debitDonor(ctx sdk.Context, state *DonationState, donorRecord *DonorRecord, amount sdk.Coins, refundUSD sdk.Dec) DonorTier {
+	// Update donor record
+	previousTier := donorRecord.Tier
+	donorRecord.TotalDonated = donorRecord.TotalDonated.Sub(amount...)
+	donorRecord.TotalUSD = addUSD(donorRecord.TotalUSD, refundUSD.Neg())
+	subContribution(donorRecord, amount, refundUSD)
+	donorRecord.Tier = k.donorTier(ctx, *donorRecord)
+
+	// Update state
+	state.TotalDonations = state.TotalDonations.Sub(amount...)
+
+	k.SetDonor(ctx, *donorRecord)
+	k.SetState(ctx, *state)
+
+	return previousTier
+}
+
+// MaxPauseReasonLength is the longest pause reason accepted, in bytes
+const MaxPauseReasonLength = 256
+
+// Pause pauses the contract. reason is recorded in the state and the event;
+// a non-zero unpauseHeight schedules BeginBlocker to resume donations at
+// the start of that block, for maintenance windows.
+func (k Keeper) Pause(ctx sdk.Context, admin string, reason string, unpauseHeight int64) error {
 	state, found := k.GetState(ctx)
 	if !found || !state.Initialized {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
 	}
 
-	if admin != state.Admin {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can pause")
+	if !k.HasRole(ctx, admin, RolePauser) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "PAUSER role required")
 	}
 
 	if state.Paused {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "already paused")
 	}
 
+	if len(reason) > MaxPauseReasonLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pause reason longer than %d bytes", MaxPauseReasonLength)
+	}
+
+	if unpauseHeight != 0 && unpauseHeight <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unpause height %d is not after the current block", unpauseHeight)
+	}
+
 	state.Paused = true
+	state.PauseReason = reason
+	state.UnpauseHeight = unpauseHeight
 	k.SetState(ctx, state)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"contract_paused",
-			sdk.NewAttribute("admin", admin),
-			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", ctx.BlockTime().Unix())),
-		),
-	)
+	if err := k.recordAudit(ctx, admin, AuditPause, map[string]interface{}{
+		"reason":         reason,
+		"unpause_height": unpauseHeight,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventPaused{
+		Admin:         admin,
+		Timestamp:     ctx.BlockTime().Unix(),
+		Reason:        reason,
+		UnpauseHeight: unpauseHeight,
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -278,24 +966,49 @@ func (k Keeper) Unpause(ctx sdk.Context, admin string) error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
 	}
 
-	if admin != state.Admin {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only admin can unpause")
+	if !k.HasRole(ctx, admin, RolePauser) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "PAUSER role required")
 	}
 
 	if !state.Paused {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not paused")
 	}
 
+	return k.unpause(ctx, state, admin)
+}
+
+// autoUnpause resumes donations once the pause's unpause height is
+// reached. Called from BeginBlocker.
+func (k Keeper) autoUnpause(ctx sdk.Context) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Paused || state.UnpauseHeight == 0 || ctx.BlockHeight() < state.UnpauseHeight {
+		return nil
+	}
+
+	return k.unpause(ctx, state, "")
+}
+
+// unpause clears the pause; admin is empty when BeginBlocker unpauses
+func (k Keeper) unpause(ctx sdk.Context, state DonationState, admin string) error {
 	state.Paused = false
+	state.PauseReason = ""
+	state.UnpauseHeight = 0
 	k.SetState(ctx, state)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"contract_unpaused",
-			sdk.NewAttribute("admin", admin),
-			sdk.NewAttribute("timestamp", fmt.Sprintf("%d", ctx.BlockTime().Unix())),
-		),
-	)
+	// A scheduled unpause was audited with its pause
+	if admin != "" {
+		if err := k.recordAudit(ctx, admin, AuditUnpause, map[string]interface{}{}); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventUnpaused{
+		Admin:     admin,
+		Timestamp: ctx.BlockTime().Unix(),
+		Automatic: admin == "",
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -304,84 +1017,87 @@ func (k Keeper) Unpause(ctx sdk.Context, admin string) error {
 
 // GetState retrieves the donation state
 func (k Keeper) GetState(ctx sdk.Context) (DonationState, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(StateKey)
-	if bz == nil {
+	state, err := k.state.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
 		return DonationState{}, false
 	}
-
-	var state DonationState
-	k.cdc.MustUnmarshal(bz, &state)
+	if err != nil {
+		panic(err)
+	}
 	return state, true
 }
 
 // SetState stores the donation state
 func (k Keeper) SetState(ctx sdk.Context, state DonationState) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&state)
-	store.Set(StateKey, bz)
+	if err := k.state.Set(ctx, state); err != nil {
+		panic(err)
+	}
 }
 
 // GetDonor retrieves a donor record
 func (k Keeper) GetDonor(ctx sdk.Context, addr string) (DonorRecord, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(GetDonorKey(addr))
-	if bz == nil {
+	donor, err := k.donors.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
 		return DonorRecord{}, false
 	}
-
-	var donor DonorRecord
-	k.cdc.MustUnmarshal(bz, &donor)
+	if err != nil {
+		panic(err)
+	}
 	return donor, true
 }
 
-// SetDonor stores a donor record
+// SetDonor stores a donor record and keeps the leaderboard and tier stats
+// in sync; the donors collection maintains the tier index
 func (k Keeper) SetDonor(ctx sdk.Context, donor DonorRecord) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&donor)
-	store.Set(GetDonorKey(donor.Address), bz)
+	previous, existed := k.GetDonor(ctx, donor.Address)
+
+	if err := k.donors.Set(ctx, donor.Address, donor); err != nil {
+		panic(err)
+	}
+
+	k.updateLeaderboard(ctx, donor)
+	k.updateTierStats(ctx, previous, existed, donor)
 }
 
 // GetAllDonors returns all donor records
 func (k Keeper) GetAllDonors(ctx sdk.Context) []DonorRecord {
-	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, DonorKeyPrefix)
-	defer iterator.Close()
-
 	donors := []DonorRecord{}
-	for ; iterator.Valid(); iterator.Next() {
-		var donor DonorRecord
-		k.cdc.MustUnmarshal(iterator.Value(), &donor)
+	err := k.donors.Walk(ctx, nil, func(_ string, donor DonorRecord) (bool, error) {
 		donors = append(donors, donor)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 
 	return donors
 }
 
-// CalculateTier calculates the donor tier based on total contribution
-func (k Keeper) CalculateTier(amount sdk.Coins) DonorTier {
-	// Assuming ATOM with 6 decimals (uatom)
-	const (
-		ATOM             = 1_000_000
-		BronzeThreshold  = 10_000        // 0.01 ATOM
-		SilverThreshold  = 100_000       // 0.1 ATOM
-		GoldThreshold    = 1_000_000     // 1 ATOM
-		PlatinumThreshold = 10_000_000   // 10 ATOM
-	)
-
-	// Get total amount in base units
-	totalAmount := amount.AmountOf("uatom").Int64()
-
-	if totalAmount >= PlatinumThreshold {
-		return TierPlatinum
-	} else if totalAmount >= GoldThreshold {
-		return TierGold
-	} else if totalAmount >= SilverThreshold {
-		return TierSilver
-	} else if totalAmount >= BronzeThreshold {
-		return TierBronze
-	}
+// tierThresholds are the minimum tier scores in tier-denom units (uatom,
+// 6 decimals), highest tier first
+var tierThresholds = []struct {
+	tier DonorTier
+	min  sdkmath.Int
+}{
+	{TierPlatinum, sdkmath.NewInt(10_000_000)}, // 10 ATOM
+	{TierGold, sdkmath.NewInt(1_000_000)},      // 1 ATOM
+	{TierSilver, sdkmath.NewInt(100_000)},      // 0.1 ATOM
+	{TierBronze, sdkmath.NewInt(10_000)},       // 0.01 ATOM
+}
+
+// CalculateTier calculates the donor tier based on total contribution,
+// weighted per denom by Params.TierWeights
+func (k Keeper) CalculateTier(ctx sdk.Context, amount sdk.Coins) DonorTier {
+	return tierForScore(k.tierScore(ctx, amount))
+}
 
+// tierForScore returns the tier a weighted tier score reaches
+func tierForScore(score sdkmath.Int) DonorTier {
+	for _, t := range tierThresholds {
+		if score.GTE(t.min) {
+			return t.tier
+		}
+	}
 	return TierNone
 }
 
@@ -0,0 +1,298 @@
+package donation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/donation-contract/cosmos-donation/testutil"
+)
+
+var (
+	testAdmin     = sdk.AccAddress("admin_______________")
+	testDonor     = sdk.AccAddress("donor_______________")
+	testRecipient = sdk.AccAddress("recipient___________")
+)
+
+type keeperFixture struct {
+	ctx   sdk.Context
+	k     Keeper
+	bank  *testutil.MockBankKeeper
+	distr *testutil.MockDistrKeeper
+	nft   *testutil.MockNFTKeeper
+}
+
+// setupKeeper builds a keeper over an in-memory store with mocked expected
+// keepers, initialized with a 0.01–100 ATOM donation range
+func setupKeeper(t *testing.T) keeperFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(ModuleName)
+	ctx := sdktestutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(ModuleName).Return(authtypes.NewModuleAddress(ModuleName)).AnyTimes()
+
+	f := keeperFixture{
+		ctx:   ctx,
+		bank:  testutil.NewMockBankKeeper(ctrl),
+		distr: testutil.NewMockDistrKeeper(ctrl),
+		nft:   testutil.NewMockNFTKeeper(ctrl),
+	}
+	f.k = NewKeeper(
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		key,
+		accountKeeper,
+		f.bank,
+		f.distr,
+		testutil.NewMockStakingKeeper(ctrl),
+		f.nft,
+		testutil.NewMockTransferKeeper(ctrl),
+		nil,
+		nil,
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	if err := f.k.Initialize(ctx, testAdmin.String(), uatom(10_000), uatom(100_000_000)); err != nil {
+		t.Fatalf("Initialize: %v", err)
+	}
+
+	return f
+}
+
+func uatom(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount))
+}
+
+// expectBadgeMint expects the donor's first badge to be minted
+func (f keeperFixture) expectBadgeMint(owner sdk.AccAddress) {
+	f.nft.EXPECT().HasClass(gomock.Any(), BadgeClassID).Return(true)
+	f.nft.EXPECT().HasNFT(gomock.Any(), BadgeClassID, GetBadgeID(owner.String())).Return(false)
+	f.nft.EXPECT().Mint(gomock.Any(), gomock.Any(), owner).Return(nil)
+}
+
+func TestDonate(t *testing.T) {
+	f := setupKeeper(t)
+	f.expectBadgeMint(testDonor)
+
+	if err := f.k.Donate(f.ctx, testDonor.String(), uatom(1_000_000), "", false); err != nil {
+		t.Fatalf("Donate: %v", err)
+	}
+
+	donor, found := f.k.GetDonor(f.ctx, testDonor.String())
+	if !found {
+		t.Fatal("donor record not stored")
+	}
+	if donor.Tier != TierGold {
+		t.Errorf("tier = %s, want %s", TierToString(donor.Tier), TierToString(TierGold))
+	}
+	if !donor.TotalDonated.IsEqual(uatom(1_000_000)) {
+		t.Errorf("donor total = %s, want 1000000uatom", donor.TotalDonated)
+	}
+
+	state, _ := f.k.GetState(f.ctx)
+	if state.DonorCount != 1 || !state.TotalDonations.IsEqual(uatom(1_000_000)) {
+		t.Errorf("state = %d donors, %s donated; want 1 donor, 1000000uatom", state.DonorCount, state.TotalDonations)
+	}
+}
+
+func TestDonateRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(f keeperFixture) error
+		amount sdk.Coins
+	}{
+		{"below minimum", nil, uatom(1)},
+		{"above maximum", nil, uatom(200_000_000)},
+		{"unlisted denom", nil, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000))},
+		{"paused", func(f keeperFixture) error { return f.k.Pause(f.ctx, testAdmin.String(), "maintenance", 0) }, uatom(1_000_000)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// No bank or NFT calls are expected: a rejected donation must not
+			// touch other modules
+			f := setupKeeper(t)
+			if tc.setup != nil {
+				if err := tc.setup(f); err != nil {
+					t.Fatalf("setup: %v", err)
+				}
+			}
+
+			if err := f.k.Donate(f.ctx, testDonor.String(), tc.amount, "", false); err == nil {
+				t.Fatal("expected the donation to be rejected")
+			}
+			if _, found := f.k.GetDonor(f.ctx, testDonor.String()); found {
+				t.Error("rejected donation created a donor record")
+			}
+		})
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	f := setupKeeper(t)
+	f.expectBadgeMint(testDonor)
+	if err := f.k.Donate(f.ctx, testDonor.String(), uatom(1_000_000), "", false); err != nil {
+		t.Fatalf("Donate: %v", err)
+	}
+
+	f.bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), ModuleName, testRecipient, uatom(400_000)).Return(nil)
+
+	if err := f.k.Withdraw(f.ctx, testAdmin.String(), uatom(400_000), testRecipient.String()); err != nil {
+		t.Fatalf("Withdraw: %v", err)
+	}
+
+	state, _ := f.k.GetState(f.ctx)
+	if !state.TotalWithdrawn.IsEqual(uatom(400_000)) {
+		t.Errorf("withdrawn = %s, want 400000uatom", state.TotalWithdrawn)
+	}
+	if !state.Withdrawable().IsEqual(uatom(600_000)) {
+		t.Errorf("withdrawable = %s, want 600000uatom", state.Withdrawable())
+	}
+}
+
+func TestWithdrawFailures(t *testing.T) {
+	bankErr := errors.New("insufficient module balance")
+
+	tests := []struct {
+		name    string
+		sender  sdk.AccAddress
+		amount  sdk.Coins
+		bankErr error // nil when the bank must not be called
+		wantErr error
+	}{
+		{"not a withdrawer", testDonor, uatom(100_000), nil, sdkerrors.ErrUnauthorized},
+		{"exceeds withdrawable", testAdmin, uatom(2_000_000), nil, sdkerrors.ErrInsufficientFunds},
+		{"bank send fails", testAdmin, uatom(100_000), bankErr, bankErr},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setupKeeper(t)
+			f.expectBadgeMint(testDonor)
+			if err := f.k.Donate(f.ctx, testDonor.String(), uatom(1_000_000), "", false); err != nil {
+				t.Fatalf("Donate: %v", err)
+			}
+			if tc.bankErr != nil {
+				f.bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), ModuleName, testRecipient, tc.amount).Return(tc.bankErr)
+			}
+
+			err := f.k.Withdraw(f.ctx, tc.sender.String(), tc.amount, testRecipient.String())
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Withdraw error = %v, want %v", err, tc.wantErr)
+			}
+
+			state, _ := f.k.GetState(f.ctx)
+			if !state.TotalWithdrawn.IsZero() {
+				t.Errorf("failed withdrawal recorded %s as withdrawn", state.TotalWithdrawn)
+			}
+		})
+	}
+}
+
+func TestRefundNetOfFeeAndBurn(t *testing.T) {
+	f := setupKeeper(t)
+	params := f.k.GetParams(f.ctx)
+	params.CommunityPoolFeeBps = 100 // 1%
+	params.BurnRate = sdk.NewDecWithPrec(1, 1)
+	f.k.SetParams(f.ctx, params)
+
+	// Each donation pays a 10000uatom fee and burns 99000uatom of the rest,
+	// leaving 891000uatom in the module account
+	for _, donor := range []sdk.AccAddress{testDonor, testRecipient} {
+		f.expectBadgeMint(donor)
+		f.distr.EXPECT().FundCommunityPool(gomock.Any(), uatom(10_000), gomock.Any()).Return(nil)
+		f.bank.EXPECT().BurnCoins(gomock.Any(), ModuleName, uatom(99_000)).Return(nil)
+		if err := f.k.Donate(f.ctx, donor.String(), uatom(1_000_000), "", false); err != nil {
+			t.Fatalf("Donate: %v", err)
+		}
+	}
+
+	f.bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), ModuleName, testDonor, uatom(891_000)).Return(nil)
+
+	refunded, err := f.k.Refund(f.ctx, testAdmin.String(), 1)
+	if err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+	if !refunded.IsEqual(uatom(891_000)) {
+		t.Errorf("refunded = %s, want 891000uatom", refunded)
+	}
+
+	// The other donor's donation is still fully held
+	state, _ := f.k.GetState(f.ctx)
+	if !state.Withdrawable().IsEqual(uatom(891_000)) {
+		t.Errorf("withdrawable = %s, want 891000uatom", state.Withdrawable())
+	}
+	if !state.TotalDonations.IsEqual(uatom(1_000_000)) {
+		t.Errorf("total donations = %s, want 1000000uatom", state.TotalDonations)
+	}
+	if !state.TotalFees.IsEqual(uatom(10_000)) || !state.TotalBurned.IsEqual(uatom(99_000)) {
+		t.Errorf("fees, burned = %s, %s; want 10000uatom, 99000uatom", state.TotalFees, state.TotalBurned)
+	}
+	if donor, _ := f.k.GetDonor(f.ctx, testDonor.String()); !donor.TotalDonated.IsZero() {
+		t.Errorf("refunded donor total = %s, want zero", donor.TotalDonated)
+	}
+}
+
+func TestRefundWithdrawalBands(t *testing.T) {
+	f := setupKeeper(t)
+	params := f.k.GetParams(f.ctx)
+	params.WithdrawalBands = WithdrawalBands{SingleMax: uatom(500_000)}
+	f.k.SetParams(f.ctx, params)
+
+	f.expectBadgeMint(testDonor)
+	if err := f.k.Donate(f.ctx, testDonor.String(), uatom(1_000_000), "", false); err != nil {
+		t.Fatalf("Donate: %v", err)
+	}
+
+	// Above SingleMax with no multisig band, so a WITHDRAWER cannot refund it
+	if _, err := f.k.Refund(f.ctx, testAdmin.String(), 1); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("Refund error = %v, want %v", err, sdkerrors.ErrUnauthorized)
+	}
+
+	f.bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), ModuleName, testDonor, uatom(1_000_000)).Return(nil)
+	if _, err := f.k.Refund(f.ctx, f.k.authority, 1); err != nil {
+		t.Fatalf("governance Refund: %v", err)
+	}
+}
+
+func TestWithdrawalBandsWindow(t *testing.T) {
+	f := setupKeeper(t)
+	f.ctx = f.ctx.WithBlockHeight(10)
+	params := f.k.GetParams(f.ctx)
+	params.WithdrawalBands = WithdrawalBands{SingleMax: uatom(500_000), WindowBlocks: 100}
+	f.k.SetParams(f.ctx, params)
+
+	f.expectBadgeMint(testDonor)
+	if err := f.k.Donate(f.ctx, testDonor.String(), uatom(1_000_000), "", false); err != nil {
+		t.Fatalf("Donate: %v", err)
+	}
+
+	f.bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), ModuleName, testRecipient, uatom(400_000)).Return(nil).Times(2)
+	if err := f.k.Withdraw(f.ctx, testAdmin.String(), uatom(400_000), testRecipient.String()); err != nil {
+		t.Fatalf("first Withdraw: %v", err)
+	}
+
+	// Together with the first, the second split exceeds SingleMax
+	f.ctx = f.ctx.WithBlockHeight(50)
+	if err := f.k.Withdraw(f.ctx, testAdmin.String(), uatom(400_000), testRecipient.String()); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("second Withdraw in the window: error = %v, want %v", err, sdkerrors.ErrUnauthorized)
+	}
+
+	// Once the first has left the window it is banded alone again
+	f.ctx = f.ctx.WithBlockHeight(110)
+	if err := f.k.Withdraw(f.ctx, testAdmin.String(), uatom(400_000), testRecipient.String()); err != nil {
+		t.Fatalf("Withdraw after the window: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Leaderboard query limits
+const (
+	DefaultLeaderboardLimit uint32 = 10
+	MaxLeaderboardLimit     uint32 = 100
+)
+
+// leaderboardScoreLen is the fixed width of the big-endian score in
+// leaderboard keys, wide enough for any sdk.Int
+const leaderboardScoreLen = 32
+
+// GetLeaderboardKey returns the leaderboard index key of a donor: the
+// big-endian score followed by the address, so that iterating the prefix
+// in reverse yields donors by descending score
+func GetLeaderboardKey(score sdk.Int, addr string) []byte {
+	return rankedKey(LeaderboardKeyPrefix, score, []byte(addr))
+}
+
+// rankedKey returns prefix | fixed-width big-endian score | id
+func rankedKey(prefix []byte, score sdk.Int, id []byte) []byte {
+	bz := make([]byte, leaderboardScoreLen)
+	score.BigInt().FillBytes(bz)
+
+	key := append([]byte{}, prefix...)
+	key = append(key, bz...)
+	return append(key, id...)
+}
+
+// GetLeaderboardPositionKey returns the key holding a donor's current
+// leaderboard index key, used to drop the stale entry on update
+func GetLeaderboardPositionKey(addr string) []byte {
+	return append(LeaderboardPositionKeyPrefix, []byte(addr)...)
+}
+
+// leaderboardScore is the amount donors are ranked by: their lifetime
+// total weighted like tiers
+func (k Keeper) leaderboardScore(ctx sdk.Context, donor DonorRecord) sdk.Int {
+	return k.tierScore(ctx, donor.TotalDonated)
+}
+
+// updateLeaderboard moves a donor's leaderboard entry to its current score
+func (k Keeper) updateLeaderboard(ctx sdk.Context, donor DonorRecord) {
+	score := k.leaderboardScore(ctx, donor)
+	k.setRankedEntry(
+		ctx,
+		GetLeaderboardPositionKey(donor.Address),
+		GetLeaderboardKey(score, donor.Address),
+		score.IsPositive(),
+	)
+}
+
+// setRankedEntry replaces the ranked index entry recorded under positionKey
+// with key, or just removes it if !keep
+func (k Keeper) setRankedEntry(ctx sdk.Context, positionKey, key []byte, keep bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	if old := store.Get(positionKey); old != nil {
+		store.Delete(old)
+		store.Delete(positionKey)
+	}
+
+	if !keep {
+		return
+	}
+
+	store.Set(key, []byte{})
+	store.Set(positionKey, key)
+}
+
+// QueryLeaderboard returns the top donors by score, highest first, with
+// anonymous donors redacted. A zero limit returns DefaultLeaderboardLimit
+// donors; limits are capped at MaxLeaderboardLimit.
+func (k Keeper) QueryLeaderboard(ctx sdk.Context, limit uint32) []DonorRecord {
+	if limit == 0 {
+		limit = DefaultLeaderboardLimit
+	}
+	if limit > MaxLeaderboardLimit {
+		limit = MaxLeaderboardLimit
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, LeaderboardKeyPrefix)
+	defer iterator.Close()
+
+	donors := []DonorRecord{}
+	for ; iterator.Valid() && uint32(len(donors)) < limit; iterator.Next() {
+		addr := string(iterator.Key()[len(LeaderboardKeyPrefix)+leaderboardScoreLen:])
+		if donor, found := k.GetDonor(ctx, addr); found {
+			donors = append(donors, k.withEffectiveTier(ctx, donor).Public())
+		}
+	}
+
+	return donors
+}
@@ -0,0 +1,130 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LoyaltyParams configures donation streaks and loyalty points. A streak
+// counts consecutive epochs with at least one donation; the first donation
+// of each epoch earns PointsPerEpoch times the streak length, capped at
+// MaxMultiplier.
+type LoyaltyParams struct {
+	EpochSeconds   int64 // zero disables streaks and points
+	PointsPerEpoch uint64
+	MaxMultiplier  uint64
+	Milestones     []uint64 // streak lengths that emit EventStreakMilestone
+}
+
+// IsSet reports whether loyalty tracking is enabled
+func (p LoyaltyParams) IsSet() bool {
+	return p.EpochSeconds > 0
+}
+
+// Validate performs basic validation of the loyalty params
+func (p LoyaltyParams) Validate() error {
+	if !p.IsSet() {
+		return nil
+	}
+	if p.MaxMultiplier == 0 {
+		return fmt.Errorf("loyalty max multiplier must be positive")
+	}
+	for i, milestone := range p.Milestones {
+		if milestone == 0 {
+			return fmt.Errorf("streak milestones must be positive")
+		}
+		if i > 0 && milestone <= p.Milestones[i-1] {
+			return fmt.Errorf("streak milestones must be increasing")
+		}
+	}
+	return nil
+}
+
+// Epoch returns the loyalty epoch containing unix time t
+func (p LoyaltyParams) Epoch(t int64) int64 {
+	return t / p.EpochSeconds
+}
+
+// isMilestone reports whether streak is one of the milestones
+func (p LoyaltyParams) isMilestone(streak uint64) bool {
+	for _, milestone := range p.Milestones {
+		if milestone == streak {
+			return true
+		}
+	}
+	return false
+}
+
+// LoyaltyStatus is a donor's loyalty standing as of the current block
+type LoyaltyStatus struct {
+	Points        uint64
+	Streak        uint64 // zero once an epoch has passed without a donation
+	LongestStreak uint64
+	LastEpoch     int64
+}
+
+// updateStreak counts a donation toward the donor's streak and awards the
+// epoch's points on its first donation, emitting EventStreakMilestone when
+// the streak reaches a milestone
+func (k Keeper) updateStreak(ctx sdk.Context, donor *DonorRecord) error {
+	params := k.GetParams(ctx).Loyalty
+	if !params.IsSet() {
+		return nil
+	}
+
+	epoch := params.Epoch(ctx.BlockTime().Unix())
+	switch {
+	case donor.Streak > 0 && donor.StreakEpoch == epoch:
+		return nil
+	case donor.Streak > 0 && donor.StreakEpoch == epoch-1:
+		donor.Streak++
+	default:
+		donor.Streak = 1
+	}
+	donor.StreakEpoch = epoch
+	if donor.Streak > donor.LongestStreak {
+		donor.LongestStreak = donor.Streak
+	}
+
+	multiplier := donor.Streak
+	if multiplier > params.MaxMultiplier {
+		multiplier = params.MaxMultiplier
+	}
+	donor.LoyaltyPoints += params.PointsPerEpoch * multiplier
+
+	if !params.isMilestone(donor.Streak) {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventStreakMilestone{
+		Donor:  publicDonor(*donor),
+		Streak: donor.Streak,
+		Epoch:  epoch,
+		Points: donor.LoyaltyPoints,
+	})
+}
+
+// QueryLoyalty returns the donor's loyalty points and streak. The streak is
+// reported as broken once a full epoch has passed without a donation, even
+// though the record is only updated by the donor's next donation.
+func (k Keeper) QueryLoyalty(ctx sdk.Context, donor string) (LoyaltyStatus, bool) {
+	record, found := k.GetDonor(ctx, donor)
+	if !found {
+		return LoyaltyStatus{}, false
+	}
+
+	status := LoyaltyStatus{
+		Points:        record.LoyaltyPoints,
+		Streak:        record.Streak,
+		LongestStreak: record.LongestStreak,
+		LastEpoch:     record.StreakEpoch,
+	}
+
+	params := k.GetParams(ctx).Loyalty
+	if params.IsSet() && params.Epoch(ctx.BlockTime().Unix()) > record.StreakEpoch+1 {
+		status.Streak = 0
+	}
+
+	return status, true
+}
@@ -0,0 +1,150 @@
+package donation
+
+import (
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MatchDonations retroactively matches every donation recorded in blocks
+// [startHeight, endHeight] at ratio, funded from the sponsor's account.
+// Each donor's match is credited toward their lifetime total and tier like
+// a matching-pool match, and tiers are recomputed once per donor. Returns
+// the total matched and the number of donations matched.
+func (k Keeper) MatchDonations(
+	ctx sdk.Context,
+	sponsor string,
+	startHeight int64,
+	endHeight int64,
+	ratio sdk.Dec,
+) (sdk.Coins, uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "match ratio must be positive")
+	}
+
+	if startHeight <= 0 || startHeight > endHeight {
+		return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block range [%d, %d]", startHeight, endHeight)
+	}
+
+	if endHeight > ctx.BlockHeight() {
+		return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "block range ends after the current height %d", ctx.BlockHeight())
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// Sum the match per donor over the range
+	matches := make(map[string]sdk.Coins)
+	var count uint64
+	rng := new(collections.Range[uint64]).StartInclusive(k.firstDonationAtHeight(ctx, startHeight))
+	err = k.donations.Walk(ctx, rng, func(_ uint64, donation Donation) (bool, error) {
+		if donation.Height > endHeight {
+			return true, nil
+		}
+
+		match := sdk.NewCoins()
+		for _, coin := range donation.Amount {
+			if amount := ratio.MulInt(coin.Amount).TruncateInt(); amount.IsPositive() {
+				match = match.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		if !match.IsZero() {
+			matches[donation.Donor] = matches[donation.Donor].Add(match...)
+			count++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	donors := make([]string, 0, len(matches))
+	total := sdk.NewCoins()
+	for donor, match := range matches {
+		donors = append(donors, donor)
+		total = total.Add(match...)
+	}
+	sort.Strings(donors)
+
+	if total.IsZero() {
+		return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no donations to match in blocks [%d, %d]", startHeight, endHeight)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, ModuleName, total); err != nil {
+		return nil, 0, err
+	}
+
+	state.TotalDonations = state.TotalDonations.Add(total...)
+	k.SetState(ctx, state)
+
+	for _, donor := range donors {
+		if err := k.creditMatch(ctx, donor, matches[donor]); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventDonationsMatched{
+		Sponsor:     sponsor,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Ratio:       ratio,
+		Donations:   count,
+		Donors:      uint64(len(donors)),
+		Matched:     total,
+	}); err != nil {
+		return nil, 0, err
+	}
+
+	return total, count, nil
+}
+
+// creditMatch adds a retroactive match to a donor's record and recomputes
+// their tier
+func (k Keeper) creditMatch(ctx sdk.Context, donor string, match sdk.Coins) error {
+	donorRecord, found := k.GetDonor(ctx, donor)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor %s not found", donor)
+	}
+
+	previousTier := donorRecord.Tier
+	usd := k.usdValue(ctx, match)
+	donorRecord.TotalDonated = donorRecord.TotalDonated.Add(match...)
+	donorRecord.TotalUSD = addUSD(donorRecord.TotalUSD, usd)
+	k.addContribution(ctx, &donorRecord, match, usd)
+	donorRecord.Tier = k.donorTier(ctx, donorRecord)
+
+	if donorRecord.Tier != previousTier {
+		if err := k.updateBadge(ctx, donorRecord); err != nil {
+			return err
+		}
+	}
+
+	k.SetDonor(ctx, donorRecord)
+
+	return k.afterTierChange(ctx, donor, previousTier, donorRecord.Tier)
+}
+
+// firstDonationAtHeight returns the ID of the first donation recorded at or
+// after height. Donation IDs increase with height, so it binary searches
+// the IDs issued so far.
+func (k Keeper) firstDonationAtHeight(ctx sdk.Context, height int64) uint64 {
+	next := uint64(1)
+	if bz := ctx.KVStore(k.storeKey).Get(DonationSeqKey); bz != nil {
+		next = sdk.BigEndianToUint64(bz)
+	}
+
+	i := sort.Search(int(next-1), func(i int) bool {
+		donation, found := k.GetDonation(ctx, uint64(i)+1)
+		return !found || donation.Height >= height
+	})
+	return uint64(i) + 1
+}
@@ -0,0 +1,158 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MatchingPool holds sponsor funds used to match incoming donations
+type MatchingPool struct {
+	Balance      sdk.Coins
+	Ratio        sdk.Dec // matched amount per donated unit, e.g. 1.0 for 1:1
+	Active       bool
+	TotalMatched sdk.Coins
+
+	// Escrowed holds the sponsor funds pledged to pending challenge
+	// matches; it is not available for matching
+	Escrowed sdk.Coins
+}
+
+// FundMatchingPool moves coins from a sponsor into the matching pool
+func (k Keeper) FundMatchingPool(
+	ctx sdk.Context,
+	sponsor string,
+	amount sdk.Coins,
+) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid funding amount")
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, ModuleName, amount); err != nil {
+		return err
+	}
+
+	pool := k.GetMatchingPool(ctx)
+	pool.Balance = pool.Balance.Add(amount...)
+	k.SetMatchingPool(ctx, pool)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventMatchingPoolFunded{
+		Sponsor: sponsor,
+		Amount:  amount,
+		Balance: pool.Balance,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConfigureMatchingPool sets the match ratio and (de)activates matching;
+// governance only, as the ratio decides how fast sponsor funds are spent
+func (k Keeper) ConfigureMatchingPool(
+	ctx sdk.Context,
+	authority string,
+	ratio sdk.Dec,
+	active bool,
+) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if authority != k.authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "match ratio must be positive")
+	}
+
+	pool := k.GetMatchingPool(ctx)
+	pool.Ratio = ratio
+	pool.Active = active
+	k.SetMatchingPool(ctx, pool)
+
+	if err := k.recordAudit(ctx, authority, AuditConfigureMatching, map[string]interface{}{
+		"ratio":  ratio.String(),
+		"active": active,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventMatchingPoolConfigured{
+		Authority: authority,
+		Ratio:     ratio,
+		Active:    active,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// applyMatch computes the matched amount for a donation and debits it from
+// the pool. Each denom is matched independently, capped by the pool balance.
+func (k Keeper) applyMatch(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
+	pool := k.GetMatchingPool(ctx)
+	if !pool.Active || pool.Balance.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	matched := sdk.NewCoins()
+	for _, coin := range amount {
+		available := pool.Balance.AmountOf(coin.Denom)
+		if !available.IsPositive() {
+			continue
+		}
+
+		match := sdk.MinInt(pool.Ratio.MulInt(coin.Amount).TruncateInt(), available)
+		if match.IsPositive() {
+			matched = matched.Add(sdk.NewCoin(coin.Denom, match))
+		}
+	}
+
+	if matched.IsZero() {
+		return matched
+	}
+
+	pool.Balance = pool.Balance.Sub(matched...)
+	pool.TotalMatched = pool.TotalMatched.Add(matched...)
+	k.SetMatchingPool(ctx, pool)
+
+	return matched
+}
+
+// GetMatchingPool retrieves the matching pool, returning an empty inactive
+// pool if none has been funded yet
+func (k Keeper) GetMatchingPool(ctx sdk.Context) MatchingPool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(MatchingPoolKey)
+	if bz == nil {
+		return MatchingPool{
+			Balance:      sdk.NewCoins(),
+			Ratio:        sdk.OneDec(),
+			TotalMatched: sdk.NewCoins(),
+		}
+	}
+
+	var pool MatchingPool
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool
+}
+
+// SetMatchingPool stores the matching pool
+func (k Keeper) SetMatchingPool(ctx sdk.Context, pool MatchingPool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pool)
+	store.Set(MatchingPoolKey, bz)
+}
@@ -0,0 +1,101 @@
+package donation
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxReferralLength bounds referral codes in routing memos
+const MaxReferralLength = 32
+
+var referralPattern = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
+// RoutedDonation is a MsgDonate attributed through its tx memo
+type RoutedDonation struct {
+	Donor      string
+	Amount     sdk.Coins
+	CampaignID uint64 // 0 for general donations
+	Referral   string
+}
+
+// EncodeRoutingMemo returns the tx memo that routes the donations of a
+// transaction to campaignID, in the DonationMemo format also used by
+// ICS-20 transfers:
+//
+//	{"donation":{"campaign":1,"referral":"spring-gala"}}
+//
+// Chains running a module version without campaign fields on MsgDonate
+// record such donations as general donations; DecodeRoutedDonations
+// attributes them to the campaign off-chain.
+func EncodeRoutingMemo(campaignID uint64, referral string) (string, error) {
+	if len(referral) > MaxReferralLength || !referralPattern.MatchString(referral) {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid referral code %q", referral)
+	}
+
+	bz, err := json.Marshal(DonationMemo{Donation: &DonationRoute{
+		Campaign: campaignID,
+		Referral: referral,
+	}})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// BuildRoutedDonateTx sets msg and a routing memo for campaignID and
+// referral on txBuilder, ready for fees, signing and broadcast
+func BuildRoutedDonateTx(txBuilder client.TxBuilder, msg *MsgDonate, campaignID uint64, referral string) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	memo, err := EncodeRoutingMemo(campaignID, referral)
+	if err != nil {
+		return err
+	}
+
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		return err
+	}
+	txBuilder.SetMemo(memo)
+
+	return nil
+}
+
+// DecodeRoutedDonations returns the donations of a decoded transaction with
+// the campaign and referral from its memo. Transactions without a routing
+// memo yield general donations; a malformed routing memo is an error so
+// indexers can flag the transaction instead of misattributing it.
+func DecodeRoutedDonations(tx sdk.Tx) ([]RoutedDonation, error) {
+	var route DonationRoute
+	if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+		parsed, err := parseDonationMemo(memoTx.GetMemo())
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid routing memo")
+		}
+		if parsed != nil {
+			route = *parsed.Donation
+		}
+	}
+
+	donations := []RoutedDonation{}
+	for _, msg := range tx.GetMsgs() {
+		donate, ok := msg.(*MsgDonate)
+		if !ok {
+			continue
+		}
+		donations = append(donations, RoutedDonation{
+			Donor:      donate.Donor,
+			Amount:     donate.Amount,
+			CampaignID: route.Campaign,
+			Referral:   route.Referral,
+		})
+	}
+
+	return donations, nil
+}
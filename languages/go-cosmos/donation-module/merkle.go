@@ -0,0 +1,92 @@
+package donation
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+// Merkle trees use domain-separated SHA-256: leaves are hashed as
+// H(0x00 || data) and inner nodes as H(0x01 || left || right). An odd node
+// at the end of a level is promoted unchanged.
+
+// MerkleProofStep is one sibling on the path from a leaf to the root
+type MerkleProofStep struct {
+	Hash []byte
+	Left bool // sibling is on the left
+}
+
+// merkleLeafHash hashes leaf data
+func merkleLeafHash(data []byte) []byte {
+	h := sha256.Sum256(append([]byte{0x00}, data...))
+	return h[:]
+}
+
+func merkleInnerHash(left, right []byte) []byte {
+	buf := make([]byte, 0, 1+len(left)+len(right))
+	buf = append(buf, 0x01)
+	buf = append(buf, left...)
+	buf = append(buf, right...)
+	h := sha256.Sum256(buf)
+	return h[:]
+}
+
+// merkleRoot returns the root over the given leaf hashes; nil for no leaves
+func merkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, merkleInnerHash(level[i], level[i+1]))
+		}
+		level = next
+	}
+
+	return level[0]
+}
+
+// merkleProof returns the proof for the leaf at index
+func merkleProof(leaves [][]byte, index int) []MerkleProofStep {
+	proof := []MerkleProofStep{}
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, MerkleProofStep{Hash: level[sibling], Left: sibling < index})
+		}
+
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, merkleInnerHash(level[i], level[i+1]))
+		}
+		level = next
+		index /= 2
+	}
+
+	return proof
+}
+
+// VerifyMerkleProof checks that leaf data is included under root
+func VerifyMerkleProof(root []byte, leafData []byte, proof []MerkleProofStep) bool {
+	hash := merkleLeafHash(leafData)
+	for _, step := range proof {
+		if step.Left {
+			hash = merkleInnerHash(step.Hash, hash)
+		} else {
+			hash = merkleInnerHash(hash, step.Hash)
+		}
+	}
+
+	return bytes.Equal(hash, root)
+}
@@ -0,0 +1,76 @@
+package donation
+
+import (
+	"math/big"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Donation rejection reasons, used as the "reason" label of the
+// donation rejected counter
+const (
+	RejectNotInitialized  = "not_initialized"
+	RejectPaused          = "paused"
+	RejectInvalidAmount   = "invalid_amount"
+	RejectBelowMin        = "below_min"
+	RejectAboveMax        = "above_max"
+	RejectDenomNotAllowed = "denom_not_allowed"
+	RejectRateLimited     = "rate_limited"
+	RejectInvalidMemo     = "invalid_memo"
+	RejectDonorBlocked    = "donor_blocked"
+	RejectDonorNotAllowed = "donor_not_allowed"
+	RejectDust            = "dust"
+)
+
+// rejectDonation counts a rejected donation by reason and returns err.
+// Rejected transactions revert their state and events, so the counter is
+// kept in node telemetry rather than in the store.
+func rejectDonation(reason string, err error) error {
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, "rejected"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+
+	return err
+}
+
+// incrDonationMetrics counts a recorded donation and adds its amount, per
+// denom, to the donation_amount counter
+func incrDonationMetrics(amount sdk.Coins) {
+	telemetry.IncrCounter(1, ModuleName, "donations_total")
+
+	for _, coin := range amount {
+		value, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float32()
+		telemetry.IncrCounterWithLabels(
+			[]string{ModuleName, "donation_amount"},
+			value,
+			[]metrics.Label{telemetry.NewLabel("denom", coin.Denom)},
+		)
+	}
+}
+
+// incrWithdrawalMetrics counts a withdrawal by kind ("standard",
+// "emergency" or "campaign")
+func incrWithdrawalMetrics(kind string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, "withdrawals_total"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("kind", kind)},
+	)
+}
+
+// setStateGauges reports the donor count and whether the module is
+// paused. Gauges are set once per block from EndBlocker rather than on
+// every write, so they never count state a failed transaction reverted.
+func setStateGauges(state DonationState) {
+	telemetry.SetGauge(float32(state.DonorCount), ModuleName, "donor_count")
+
+	paused := float32(0)
+	if state.Paused {
+		paused = 1
+	}
+	telemetry.SetGauge(paused, ModuleName, "paused")
+}
@@ -0,0 +1,127 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	v4 "github.com/donation-contract/cosmos-donation/migrations/v4"
+)
+
+// ConsensusVersion is the module's consensus version. Bump it with every
+// change to the store layout or to stored types, and register a migration
+// from the previous version.
+const ConsensusVersion = 6
+
+// Migrator runs the module's in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// migrations returns the migration handlers keyed by the version they
+// migrate from, e.g. {1: m.Migrate1to2}
+func (m Migrator) migrations() map[uint64]module.MigrationHandler {
+	return map[uint64]module.MigrationHandler{
+		1: m.Migrate1to2,
+		2: m.Migrate2to3,
+		3: m.Migrate3to4,
+		4: m.Migrate4to5,
+		5: m.Migrate5to6,
+	}
+}
+
+// Migrate1to2 moves the state-wide MinDonation/MaxDonation coins into
+// per-denom Params.DonationLimits
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	state, found := m.keeper.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil
+	}
+
+	limits, err := DonationLimitsFromCoins(state.MinDonation, state.MaxDonation)
+	if err != nil {
+		return fmt.Errorf("cannot migrate donation limits: %w", err)
+	}
+
+	params := m.keeper.GetParams(ctx)
+	params.DonationLimits = limits
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+
+	state.MinDonation = nil
+	state.MaxDonation = nil
+	m.keeper.SetState(ctx, state)
+
+	return nil
+}
+
+// Migrate2to3 backfills the per-tier stats from the existing donor records
+// and the donation stats buckets and time index from the donation history.
+// The donor records are read through the donors collection, which expects
+// the version 4 key layout, so their keys are length-prefixed first.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.lengthPrefixDonorKeys(ctx)
+	m.keeper.rebuildTierStats(ctx)
+	m.keeper.rebuildDonationIndexes(ctx)
+	return nil
+}
+
+// Migrate3to4 length-prefixes the addresses in donor record keys and marks
+// the store as migrated, so historical queries know which layout a store
+// version uses
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.lengthPrefixDonorKeys(ctx)
+	return nil
+}
+
+// lengthPrefixDonorKeys runs the version 4 donor key migration unless the
+// store is already marked as migrated. Upgrades from before version 3 run
+// it from Migrate2to3, ahead of the first read of the donors collection.
+func (m Migrator) lengthPrefixDonorKeys(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	if store.Has(DonorKeysLengthPrefixedKey) {
+		return
+	}
+	v4.MigrateStore(store)
+	store.Set(DonorKeysLengthPrefixedKey, []byte{})
+}
+
+// Migrate4to5 queues the running campaigns with a deadline for
+// finalization by the EndBlocker
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.indexCampaignDeadlines(ctx)
+	return nil
+}
+
+// Migrate5to6 rebuilds the leaderboard and the tier index, which donors
+// recorded before either index existed are missing from
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.rebuildDonorIndexes(ctx)
+	return nil
+}
+
+// RegisterMigrations registers a handler for every version between 1 and
+// ConsensusVersion with the module manager's configurator, so upgrades
+// run them in order
+func RegisterMigrations(cfg module.Configurator, m Migrator) error {
+	handlers := m.migrations()
+	for from := uint64(1); from < ConsensusVersion; from++ {
+		handler, found := handlers[from]
+		if !found {
+			return fmt.Errorf("missing %s migration from version %d", ModuleName, from)
+		}
+		if err := cfg.RegisterMigration(ModuleName, from, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+// Package migrations holds the donation module's in-place store migrations.
+//
+// Each consensus version bump gets its own subpackage (migrations/v2,
+// migrations/v3, ...) exposing a MigrateStore function. A migration must not
+// import the donation package: it describes the store layout as it was, so
+// it keeps its own copies of the old keys and types, and only touches the
+// store through the helpers here. The donation Migrator calls MigrateStore
+// and RegisterMigrations wires it into the app's module manager.
+//
+// Migrations that only rewrite values with the module's current types, such
+// as moving a deprecated field into params, are Migrator methods in the
+// donation package instead.
+package migrations
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// RekeyPrefix moves every entry under oldPrefix to the key returned by
+// rekey, which receives the key with oldPrefix stripped and returns the
+// full new key. Values are copied unchanged.
+func RekeyPrefix(store storetypes.KVStore, oldPrefix []byte, rekey func(key []byte) []byte) {
+	oldStore := prefix.NewStore(store, oldPrefix)
+
+	// Collect first: writing while iterating is not allowed
+	var keys, values [][]byte
+	iterator := oldStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		oldStore.Delete(key)
+		store.Set(rekey(key), values[i])
+	}
+}
+
+// RewriteValues replaces each value under keyPrefix with rewrite's result;
+// returning nil deletes the entry
+func RewriteValues(store storetypes.KVStore, keyPrefix []byte, rewrite func(key, value []byte) ([]byte, error)) error {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+
+	var keys, values [][]byte
+	iterator := prefixStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		value, err := rewrite(key, values[i])
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			prefixStore.Delete(key)
+		} else {
+			prefixStore.Set(key, value)
+		}
+	}
+
+	return nil
+}
+
+// DeletePrefix removes every entry under keyPrefix
+func DeletePrefix(store storetypes.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+
+	var keys [][]byte
+	iterator := prefixStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,22 @@
+// Package v4 migrates the donation store from consensus version 3 to 4.
+package v4
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/donation-contract/cosmos-donation/migrations"
+)
+
+// DonorKeyPrefix is the donor record prefix, unchanged in version 4. In
+// version 3 a donor key was the prefix followed by the raw address bytes.
+var DonorKeyPrefix = []byte{0x02}
+
+// MigrateStore rekeys every donor record from prefix|address to
+// prefix|len(address)|address, so that no address key is a prefix of
+// another. The records themselves are unchanged.
+func MigrateStore(store storetypes.KVStore) {
+	migrations.RekeyPrefix(store, DonorKeyPrefix, func(addr []byte) []byte {
+		return append(append([]byte{}, DonorKeyPrefix...), address.MustLengthPrefix(addr)...)
+	})
+}
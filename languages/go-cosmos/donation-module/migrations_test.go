@@ -0,0 +1,91 @@
+package donation
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupV1Store rewrites a fresh keeper's store into the version 1 layout:
+// the donation limits live in the state and donor records are keyed by the
+// raw address bytes
+func setupV1Store(t *testing.T, donors ...DonorRecord) keeperFixture {
+	t.Helper()
+
+	f := setupKeeper(t)
+	store := f.ctx.KVStore(f.k.storeKey)
+
+	state, _ := f.k.GetState(f.ctx)
+	state.MinDonation = uatom(10_000)
+	state.MaxDonation = uatom(100_000_000)
+	state.DonorCount = uint64(len(donors))
+	f.k.SetState(f.ctx, state)
+
+	for _, donor := range donors {
+		store.Set(append(append([]byte{}, DonorKeyPrefix...), donor.Address...), f.k.cdc.MustMarshal(&donor))
+	}
+	store.Delete(DonorKeysLengthPrefixedKey)
+
+	return f
+}
+
+func TestMigrateFromV1(t *testing.T) {
+	gold := DonorRecord{
+		Address:      testDonor.String(),
+		TotalDonated: uatom(1_000_000),
+		Tier:         TierGold,
+		TotalUSD:     sdk.ZeroDec(),
+	}
+	bronze := DonorRecord{
+		Address:      testRecipient.String(),
+		TotalDonated: uatom(20_000),
+		Tier:         TierBronze,
+		TotalUSD:     sdk.ZeroDec(),
+	}
+	f := setupV1Store(t, gold, bronze)
+
+	m := NewMigrator(f.k)
+	handlers := m.migrations()
+	for from := uint64(1); from < ConsensusVersion; from++ {
+		if err := handlers[from](f.ctx); err != nil {
+			t.Fatalf("migration from version %d: %v", from, err)
+		}
+	}
+
+	for _, want := range []DonorRecord{gold, bronze} {
+		donor, found := f.k.GetDonor(f.ctx, want.Address)
+		if !found {
+			t.Fatalf("donor %s lost in migration", want.Address)
+		}
+		if donor.Tier != want.Tier || !donor.TotalDonated.IsEqual(want.TotalDonated) {
+			t.Errorf("donor %s = %s %s, want %s %s", want.Address,
+				TierToString(donor.Tier), donor.TotalDonated, TierToString(want.Tier), want.TotalDonated)
+		}
+	}
+
+	for _, tier := range []DonorTier{TierGold, TierBronze} {
+		if stats := f.k.GetTierStats(f.ctx, tier); stats.DonorCount != 1 {
+			t.Errorf("%s donor count = %d, want 1", TierToString(tier), stats.DonorCount)
+		}
+		donors, _, err := f.k.QueryDonorsByTier(f.ctx, tier, nil)
+		if err != nil {
+			t.Fatalf("QueryDonorsByTier: %v", err)
+		}
+		if len(donors) != 1 {
+			t.Errorf("%s tier index holds %d donors, want 1", TierToString(tier), len(donors))
+		}
+	}
+
+	leaderboard := f.k.QueryLeaderboard(f.ctx, 0)
+	if len(leaderboard) != 2 || leaderboard[0].Address != gold.Address {
+		t.Errorf("leaderboard = %v, want %s first of 2", leaderboard, gold.Address)
+	}
+
+	state, _ := f.k.GetState(f.ctx)
+	if state.MinDonation != nil || state.MaxDonation != nil {
+		t.Errorf("state still holds donation limits %s / %s", state.MinDonation, state.MaxDonation)
+	}
+	if !f.ctx.KVStore(f.k.storeKey).Has(DonorKeysLengthPrefixedKey) {
+		t.Error("store not marked as length-prefixed")
+	}
+}
@@ -0,0 +1,186 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	donation "github.com/donation-contract/cosmos-donation"
+	"github.com/donation-contract/cosmos-donation/simulation"
+)
+
+var (
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+	_ module.HasInvariants       = AppModule{}
+	_ module.HasConsensusVersion = AppModule{}
+	_ module.BeginBlockAppModule = AppModule{}
+	_ module.EndBlockAppModule   = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+)
+
+// AppModuleBasic implements the module's stateless parts
+type AppModuleBasic struct {
+	cdc codec.Codec
+}
+
+// Name returns the module's name
+func (AppModuleBasic) Name() string {
+	return donation.ModuleName
+}
+
+// RegisterLegacyAminoCodec registers the module's types for amino
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	donation.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the module's interface types
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	donation.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns the module's default genesis state
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(donation.DefaultGenesis())
+}
+
+// ValidateGenesis validates the module's genesis state
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs donation.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", donation.ModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
+// RegisterGRPCGatewayRoutes registers the module's REST routes for the
+// Query service
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := donation.RegisterQueryHandlerClient(context.Background(), mux, donation.NewQueryClient(clientCtx)); err != nil {
+		panic(fmt.Sprintf("failed to register %s gateway routes: %s", donation.ModuleName, err))
+	}
+}
+
+// GetTxCmd returns the module's root tx command; the module has none yet
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns the module's root query command; the module has none yet
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return nil
+}
+
+// AccountKeeper defines the account functionality the module needs for simulation
+type AccountKeeper = simulation.AccountKeeper
+
+// BankKeeper defines the bank functionality the module needs for simulation
+type BankKeeper = simulation.BankKeeper
+
+// AppModule implements the donation module for the app's module manager
+type AppModule struct {
+	AppModuleBasic
+
+	keeper        donation.Keeper
+	accountKeeper AccountKeeper
+	bankKeeper    BankKeeper
+	registry      cdctypes.InterfaceRegistry
+}
+
+// NewAppModule creates a new AppModule
+func NewAppModule(
+	cdc codec.Codec,
+	keeper donation.Keeper,
+	accountKeeper AccountKeeper,
+	bankKeeper BankKeeper,
+	registry cdctypes.InterfaceRegistry,
+) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{cdc: cdc},
+		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		registry:       registry,
+	}
+}
+
+// IsOnePerModuleType implements depinject.OnePerModuleType
+func (AppModule) IsOnePerModuleType() {}
+
+// IsAppModule implements appmodule.AppModule
+func (AppModule) IsAppModule() {}
+
+// ConsensusVersion returns the module's consensus version
+func (AppModule) ConsensusVersion() uint64 {
+	return donation.ConsensusVersion
+}
+
+// RegisterServices registers the Msg and Query services and the store
+// migrations
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	donation.RegisterMsgServer(cfg.MsgServer(), donation.NewMsgServerImpl(am.keeper))
+	donation.RegisterQueryServer(cfg.QueryServer(), donation.NewQueryServerImpl(am.keeper))
+
+	if err := donation.RegisterMigrations(cfg, donation.NewMigrator(am.keeper)); err != nil {
+		panic(fmt.Sprintf("failed to register %s migrations: %s", donation.ModuleName, err))
+	}
+}
+
+// RegisterInvariants registers the module's invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	donation.RegisterInvariants(ir, am.keeper)
+}
+
+// InitGenesis initializes the module's state from genesis
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	var gs donation.GenesisState
+	cdc.MustUnmarshalJSON(bz, &gs)
+	donation.InitGenesis(ctx, am.keeper, gs)
+	return nil
+}
+
+// ExportGenesis exports the module's state as genesis
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(donation.ExportGenesis(ctx, am.keeper))
+}
+
+// BeginBlock runs the module's start-of-block processing
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	donation.BeginBlocker(ctx, am.keeper)
+}
+
+// EndBlock runs the module's end-of-block processing
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	donation.EndBlocker(ctx, am.keeper)
+	return nil
+}
+
+// GenerateGenesisState creates a randomized genesis state for simulation
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers the module's store decoder for simulation
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[donation.ModuleName] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the module's simulation operations
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		am.registry, simState.AppParams, simState.Cdc,
+		am.accountKeeper, am.bankKeeper, am.keeper,
+	)
+}
@@ -0,0 +1,48 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleAccountInfo puts the module account's live bank balances next to
+// the balances the module tracks, for reconciliation by explorers and auditors
+type ModuleAccountInfo struct {
+	Address      string
+	Balances     sdk.Coins // live x/bank balances
+	Withdrawable sdk.Coins // tracked donations not yet withdrawn
+	MatchingPool sdk.Coins // sponsor funds reserved for matching
+	Escrowed     sdk.Coins // sponsor funds pledged to pending challenge matches
+	Rewards      sdk.Coins // reward pool and rewards awaiting claims
+	Held         sdk.Coins // donations in escrow for their clawback window
+	Untracked    sdk.Coins // balances not accounted for by the above
+}
+
+// QueryModuleAccount returns the module account address and its balances
+func (k Keeper) QueryModuleAccount(ctx sdk.Context) ModuleAccountInfo {
+	addr := k.moduleAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, addr)
+
+	withdrawable, held := sdk.NewCoins(), sdk.NewCoins()
+	if state, found := k.GetState(ctx); found {
+		withdrawable = state.Withdrawable()
+		held = state.HeldDonations
+	}
+	pool := k.GetMatchingPool(ctx)
+	rewards := k.GetRewardPool(ctx)
+	rewardFunds := rewards.Balance.Add(rewards.Allocated...)
+
+	// A shortfall (tracked exceeding live) leaves Untracked empty and shows
+	// up as Balances being less than the tracked sum
+	diff, _ := balances.SafeSub(withdrawable.Add(pool.Balance...).Add(pool.Escrowed...).Add(rewardFunds...).Add(held...)...)
+
+	return ModuleAccountInfo{
+		Address:      addr.String(),
+		Balances:     balances,
+		Withdrawable: withdrawable,
+		MatchingPool: pool.Balance,
+		Escrowed:     pool.Escrowed,
+		Rewards:      rewardFunds,
+		Held:         held,
+		Untracked:    positiveCoins(diff),
+	}
+}
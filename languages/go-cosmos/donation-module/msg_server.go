@@ -0,0 +1,648 @@
+package donation
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the donation MsgServer
+func NewMsgServerImpl(keeper Keeper) MsgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// Donate moves the donation into the module account, records it, credits
+// the campaign if one is given and returns its receipt ID
+func (m msgServer) Donate(goCtx context.Context, msg *MsgDonate) (*MsgDonateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donor, err := sdk.AccAddressFromBech32(msg.Donor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, donor, ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if m.Keeper.escrowEnabled(ctx) {
+		id, err := m.Keeper.EscrowDonation(ctx, msg.Donor, msg.CampaignID, msg.Amount, msg.Memo, msg.Anonymous)
+		if err != nil {
+			return nil, err
+		}
+		return &MsgDonateResponse{EscrowID: id}, nil
+	}
+
+	var donation Donation
+	if msg.CampaignID != 0 {
+		donation, err = m.Keeper.donateToCampaign(ctx, msg.Donor, msg.CampaignID, msg.Amount, msg.Memo, msg.Anonymous)
+	} else {
+		donation, err = m.Keeper.donate(ctx, msg.Donor, msg.Amount, msg.Memo, msg.Anonymous)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgDonateResponse{ReceiptID: donation.ReceiptID}, nil
+}
+
+// BatchDonate moves the batch total into the module account in a single
+// transfer and records each entry
+func (m msgServer) BatchDonate(goCtx context.Context, msg *MsgBatchDonate) (*MsgBatchDonateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donor, err := sdk.AccAddressFromBech32(msg.Donor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, donor, ModuleName, msg.Total()); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.BatchDonate(ctx, msg.Donor, msg.Entries, msg.Memo, msg.Anonymous); err != nil {
+		return nil, err
+	}
+
+	return &MsgBatchDonateResponse{}, nil
+}
+
+// Withdraw withdraws donated funds
+func (m msgServer) Withdraw(goCtx context.Context, msg *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.Withdraw(ctx, msg.Sender, msg.Amount, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	return &MsgWithdrawResponse{}, nil
+}
+
+// Distribute withdraws donated funds split across the beneficiaries
+func (m msgServer) Distribute(goCtx context.Context, msg *MsgDistribute) (*MsgDistributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.Distribute(ctx, msg.Sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgDistributeResponse{}, nil
+}
+
+// SetBeneficiaries replaces the weighted beneficiaries
+func (m msgServer) SetBeneficiaries(goCtx context.Context, msg *MsgSetBeneficiaries) (*MsgSetBeneficiariesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetBeneficiaries(ctx, msg.Sender, msg.Beneficiaries); err != nil {
+		return nil, err
+	}
+
+	return &MsgSetBeneficiariesResponse{}, nil
+}
+
+// RegisterOrganization adds or updates a beneficiary registry entry
+func (m msgServer) RegisterOrganization(goCtx context.Context, msg *MsgRegisterOrganization) (*MsgRegisterOrganizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RegisterOrganization(ctx, msg.Sender, msg.Address, msg.Name, msg.MetadataURI); err != nil {
+		return nil, err
+	}
+
+	return &MsgRegisterOrganizationResponse{}, nil
+}
+
+// SetOrganizationStatus verifies or revokes a registered organization
+func (m msgServer) SetOrganizationStatus(goCtx context.Context, msg *MsgSetOrganizationStatus) (*MsgSetOrganizationStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	status, err := VerificationStatusFromString(msg.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.SetOrganizationStatus(ctx, msg.Sender, msg.Address, status); err != nil {
+		return nil, err
+	}
+
+	return &MsgSetOrganizationStatusResponse{}, nil
+}
+
+// Pause pauses donations
+func (m msgServer) Pause(goCtx context.Context, msg *MsgPause) (*MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.Pause(ctx, msg.Sender, msg.Reason, msg.UnpauseHeight); err != nil {
+		return nil, err
+	}
+
+	return &MsgPauseResponse{}, nil
+}
+
+// Unpause resumes donations
+func (m msgServer) Unpause(goCtx context.Context, msg *MsgUnpause) (*MsgUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.Unpause(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &MsgUnpauseResponse{}, nil
+}
+
+// UpdateDonorList adds and removes addresses from a donor list
+func (m msgServer) UpdateDonorList(goCtx context.Context, msg *MsgUpdateDonorList) (*MsgUpdateDonorListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	list, err := DonorListFromString(msg.List)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.UpdateDonorList(ctx, msg.Sender, list, msg.Add, msg.Remove); err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateDonorListResponse{}, nil
+}
+
+// SetAllowlistMode turns allowlist mode on or off
+func (m msgServer) SetAllowlistMode(goCtx context.Context, msg *MsgSetAllowlistMode) (*MsgSetAllowlistModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetAllowlistMode(ctx, msg.Sender, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &MsgSetAllowlistModeResponse{}, nil
+}
+
+// SweepDust sends module account dust to the community pool
+func (m msgServer) SweepDust(goCtx context.Context, msg *MsgSweepDust) (*MsgSweepDustResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	swept, err := m.Keeper.SweepDust(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSweepDustResponse{Swept: swept}, nil
+}
+
+// DelegateFunds delegates idle donation funds to a validator
+func (m msgServer) DelegateFunds(goCtx context.Context, msg *MsgDelegateFunds) (*MsgDelegateFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.DelegateFunds(ctx, msg.Sender, msg.Validator, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgDelegateFundsResponse{}, nil
+}
+
+// UndelegateFunds starts unbonding delegated donation funds
+func (m msgServer) UndelegateFunds(goCtx context.Context, msg *MsgUndelegateFunds) (*MsgUndelegateFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	completion, err := m.Keeper.UndelegateFunds(ctx, msg.Sender, msg.Validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUndelegateFundsResponse{CompletionTime: completion}, nil
+}
+
+// ClaimStakingRewards claims the rewards of the module's delegations
+func (m msgServer) ClaimStakingRewards(goCtx context.Context, msg *MsgClaimStakingRewards) (*MsgClaimStakingRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards, err := m.Keeper.ClaimStakingRewards(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgClaimStakingRewardsResponse{Rewards: rewards}, nil
+}
+
+// BoostCampaign buys a ranking boost for a campaign
+func (m msgServer) BoostCampaign(goCtx context.Context, msg *MsgBoostCampaign) (*MsgBoostCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	boost, err := m.Keeper.BoostCampaign(ctx, msg.Sponsor, msg.CampaignID, msg.Amount, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgBoostCampaignResponse{BoostID: boost.ID, Expiry: boost.Expiry}, nil
+}
+
+// SetVestingSchedule sets or cancels a beneficiary's vesting schedule
+func (m msgServer) SetVestingSchedule(goCtx context.Context, msg *MsgSetVestingSchedule) (*MsgSetVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetVestingSchedule(ctx, msg.Sender, msg.Beneficiary, msg.Total, msg.Cliff, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &MsgSetVestingScheduleResponse{}, nil
+}
+
+// CreateChallengeMatch escrows a conditional sponsor match for a campaign
+func (m msgServer) CreateChallengeMatch(goCtx context.Context, msg *MsgCreateChallengeMatch) (*MsgCreateChallengeMatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.CreateChallengeMatch(ctx, msg.Sponsor, msg.CampaignID, msg.Target, msg.Deadline, msg.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateChallengeMatchResponse{ID: id}, nil
+}
+
+// UpdateDonationLimits changes the min and max donation of the listed denoms
+func (m msgServer) UpdateDonationLimits(goCtx context.Context, msg *MsgUpdateDonationLimits) (*MsgUpdateDonationLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateDonationLimits(ctx, msg.Sender, msg.MinDonation, msg.MaxDonation); err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateDonationLimitsResponse{}, nil
+}
+
+// SetCircuitBreaker disables or re-enables individual Msgs
+func (m msgServer) SetCircuitBreaker(goCtx context.Context, msg *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetCircuitBreaker(ctx, msg.Sender, msg.TypeURLs, msg.Disabled); err != nil {
+		return nil, err
+	}
+
+	return &MsgSetCircuitBreakerResponse{}, nil
+}
+
+// SnapshotDonors schedules a donor-set snapshot
+func (m msgServer) SnapshotDonors(goCtx context.Context, msg *MsgSnapshotDonors) (*MsgSnapshotDonorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.ScheduleDonorSnapshot(ctx, msg.Sender, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSnapshotDonorsResponse{SnapshotID: id}, nil
+}
+
+// FundRewardPool adds to the donor reward pool
+func (m msgServer) FundRewardPool(goCtx context.Context, msg *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.FundRewardPool(ctx, msg.Sponsor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgFundRewardPoolResponse{}, nil
+}
+
+// DistributeRewards allocates reward pool funds to donors
+func (m msgServer) DistributeRewards(goCtx context.Context, msg *MsgDistributeRewards) (*MsgDistributeRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.DistributeRewards(ctx, msg.Sender, msg.Amount, msg.ClaimPeriod, msg.TierMultipliers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgDistributeRewardsResponse{DistributionID: id}, nil
+}
+
+// ClaimReward pays out a donor's reward
+func (m msgServer) ClaimReward(goCtx context.Context, msg *MsgClaimReward) (*MsgClaimRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := m.Keeper.ClaimReward(ctx, msg.Donor, msg.DistributionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgClaimRewardResponse{Amount: amount}, nil
+}
+
+// RegisterRemoteDonationAccount opens the module's interchain account on a
+// connection
+func (m msgServer) RegisterRemoteDonationAccount(goCtx context.Context, msg *MsgRegisterRemoteDonationAccount) (*MsgRegisterRemoteDonationAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RegisterRemoteDonationAccount(ctx, msg.Sender, msg.ConnectionID); err != nil {
+		return nil, err
+	}
+
+	return &MsgRegisterRemoteDonationAccountResponse{}, nil
+}
+
+// ForwardDonation forwards funds to another chain
+func (m msgServer) ForwardDonation(goCtx context.Context, msg *MsgForwardDonation) (*MsgForwardDonationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.ForwardDonation(ctx, msg.Sender, msg.ConnectionID, msg.TransferChannel, msg.Amount, msg.Recipient, msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgForwardDonationResponse{ForwardID: id}, nil
+}
+
+// RetryForwardDonation resumes a failed forwarded donation
+func (m msgServer) RetryForwardDonation(goCtx context.Context, msg *MsgRetryForwardDonation) (*MsgRetryForwardDonationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RetryForwardDonation(ctx, msg.Sender, msg.ForwardID); err != nil {
+		return nil, err
+	}
+
+	return &MsgRetryForwardDonationResponse{}, nil
+}
+
+// Clawback returns an escrowed donation to its donor
+func (m msgServer) Clawback(goCtx context.Context, msg *MsgClawback) (*MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := m.Keeper.Clawback(ctx, msg.Donor, msg.EscrowID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgClawbackResponse{Amount: amount}, nil
+}
+
+// ClaimCampaignRefund returns a donor's contribution to a failed campaign
+func (m msgServer) ClaimCampaignRefund(goCtx context.Context, msg *MsgClaimCampaignRefund) (*MsgClaimCampaignRefundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := m.Keeper.ClaimCampaignRefund(ctx, msg.Donor, msg.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgClaimCampaignRefundResponse{Amount: amount}, nil
+}
+
+// MatchDonations matches the donations recorded in a block range
+func (m msgServer) MatchDonations(goCtx context.Context, msg *MsgMatchDonations) (*MsgMatchDonationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	matched, donations, err := m.Keeper.MatchDonations(ctx, msg.Sponsor, msg.StartHeight, msg.EndHeight, msg.Ratio)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgMatchDonationsResponse{Matched: matched, Donations: donations}, nil
+}
+
+// Refund returns a donation to its donor
+func (m msgServer) Refund(goCtx context.Context, msg *MsgRefund) (*MsgRefundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := m.Keeper.Refund(ctx, msg.Sender, msg.DonationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRefundResponse{Amount: amount}, nil
+}
+
+// ArchiveCampaign freezes a finished campaign
+func (m msgServer) ArchiveCampaign(goCtx context.Context, msg *MsgArchiveCampaign) (*MsgArchiveCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ArchiveCampaign(ctx, msg.Sender, msg.CampaignID); err != nil {
+		return nil, err
+	}
+
+	return &MsgArchiveCampaignResponse{}, nil
+}
+
+// UnarchiveCampaign reopens an archived campaign
+func (m msgServer) UnarchiveCampaign(goCtx context.Context, msg *MsgUnarchiveCampaign) (*MsgUnarchiveCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UnarchiveCampaign(ctx, msg.Authority, msg.CampaignID); err != nil {
+		return nil, err
+	}
+
+	return &MsgUnarchiveCampaignResponse{}, nil
+}
+
+// TransferAdmin proposes a new admin
+func (m msgServer) TransferAdmin(goCtx context.Context, msg *MsgTransferAdmin) (*MsgTransferAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.TransferAdmin(ctx, msg.Admin, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &MsgTransferAdminResponse{}, nil
+}
+
+// AcceptAdmin completes a pending admin transfer
+func (m msgServer) AcceptAdmin(goCtx context.Context, msg *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.AcceptAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &MsgAcceptAdminResponse{}, nil
+}
+
+// GrantRole grants a role to an address
+func (m msgServer) GrantRole(goCtx context.Context, msg *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.GrantRole(ctx, msg.Sender, msg.Address, msg.Role); err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantRoleResponse{}, nil
+}
+
+// RevokeRole revokes a role from an address
+func (m msgServer) RevokeRole(goCtx context.Context, msg *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RevokeRole(ctx, msg.Sender, msg.Address, msg.Role); err != nil {
+		return nil, err
+	}
+
+	return &MsgRevokeRoleResponse{}, nil
+}
+
+// ScheduleEmergencyWithdraw starts the emergency withdrawal timelock
+func (m msgServer) ScheduleEmergencyWithdraw(goCtx context.Context, msg *MsgScheduleEmergencyWithdraw) (*MsgScheduleEmergencyWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending, err := m.Keeper.ScheduleEmergencyWithdraw(ctx, msg.Sender, msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgScheduleEmergencyWithdrawResponse{ExecutableHeight: pending.ExecutableHeight}, nil
+}
+
+// EmergencyWithdraw executes a scheduled emergency withdrawal
+func (m msgServer) EmergencyWithdraw(goCtx context.Context, msg *MsgEmergencyWithdraw) (*MsgEmergencyWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := m.Keeper.EmergencyWithdraw(ctx, msg.Sender, msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgEmergencyWithdrawResponse{Amount: amount}, nil
+}
+
+// CancelEmergencyWithdraw cancels a scheduled emergency withdrawal
+func (m msgServer) CancelEmergencyWithdraw(goCtx context.Context, msg *MsgCancelEmergencyWithdraw) (*MsgCancelEmergencyWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelEmergencyWithdraw(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &MsgCancelEmergencyWithdrawResponse{}, nil
+}
+
+// UpdateParams announces new module parameters
+func (m msgServer) UpdateParams(goCtx context.Context, msg *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateParamsResponse{}, nil
+}
+
+// CancelParamsChange drops the pending param change
+func (m msgServer) CancelParamsChange(goCtx context.Context, msg *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelParamsChange(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &MsgCancelParamsChangeResponse{}, nil
+}
+
+// CreateCampaign registers a new campaign
+func (m msgServer) CreateCampaign(goCtx context.Context, msg *MsgCreateCampaign) (*MsgCreateCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.CreateCampaign(ctx, msg.Creator, msg.Title, msg.Beneficiary, msg.Goal, msg.Deadline, msg.AcceptedDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateCampaignResponse{CampaignID: id}, nil
+}
+
+// CreateTeam registers a team
+func (m msgServer) CreateTeam(goCtx context.Context, msg *MsgCreateTeam) (*MsgCreateTeamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.CreateTeam(ctx, msg.Creator, msg.Name, msg.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateTeamResponse{TeamID: id}, nil
+}
+
+// JoinTeam adds the sender to a team
+func (m msgServer) JoinTeam(goCtx context.Context, msg *MsgJoinTeam) (*MsgJoinTeamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.JoinTeam(ctx, msg.Member, msg.TeamID); err != nil {
+		return nil, err
+	}
+
+	return &MsgJoinTeamResponse{}, nil
+}
+
+// LeaveTeam removes the sender from their team
+func (m msgServer) LeaveTeam(goCtx context.Context, msg *MsgLeaveTeam) (*MsgLeaveTeamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.LeaveTeam(ctx, msg.Member); err != nil {
+		return nil, err
+	}
+
+	return &MsgLeaveTeamResponse{}, nil
+}
+
+// FundMatchingPool moves sponsor funds into the matching pool
+func (m msgServer) FundMatchingPool(goCtx context.Context, msg *MsgFundMatchingPool) (*MsgFundMatchingPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.FundMatchingPool(ctx, msg.Sponsor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgFundMatchingPoolResponse{}, nil
+}
+
+// ConfigureMatchingPool sets the match ratio and (de)activates matching
+func (m msgServer) ConfigureMatchingPool(goCtx context.Context, msg *MsgConfigureMatchingPool) (*MsgConfigureMatchingPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ConfigureMatchingPool(ctx, msg.Authority, msg.Ratio, msg.Active); err != nil {
+		return nil, err
+	}
+
+	return &MsgConfigureMatchingPoolResponse{}, nil
+}
+
+// PostCampaignUpdate appends an update to a campaign's feed
+func (m msgServer) PostCampaignUpdate(goCtx context.Context, msg *MsgPostCampaignUpdate) (*MsgPostCampaignUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	index, err := m.Keeper.PostCampaignUpdate(ctx, msg.Author, msg.CampaignID, msg.Title, msg.URI, msg.ContentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgPostCampaignUpdateResponse{Index: index}, nil
+}
+
+// SetCampaignExtensionPolicy sets a campaign's extension policy
+func (m msgServer) SetCampaignExtensionPolicy(goCtx context.Context, msg *MsgSetCampaignExtensionPolicy) (*MsgSetCampaignExtensionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetCampaignExtensionPolicy(ctx, msg.Sender, msg.CampaignID, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	return &MsgSetCampaignExtensionPolicyResponse{}, nil
+}
+
+// RecordIdentityAttestation records a donor's identity attestation
+func (m msgServer) RecordIdentityAttestation(goCtx context.Context, msg *MsgRecordIdentityAttestation) (*MsgRecordIdentityAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	attestation := IdentityAttestation{
+		Donor:          msg.Donor,
+		Issuer:         msg.Issuer,
+		CredentialHash: msg.CredentialHash,
+		ExpiresAt:      msg.ExpiresAt,
+	}
+	if err := m.Keeper.RecordIdentityAttestation(ctx, msg.Attester, attestation); err != nil {
+		return nil, err
+	}
+
+	return &MsgRecordIdentityAttestationResponse{}, nil
+}
@@ -0,0 +1,113 @@
+package donation
+
+import (
+	"context"
+
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+// Msg service wiring for proto/donation/v1/tx.proto, kept by hand next to
+// the hand-written Msg types
+
+// RegisterMsgServer registers srv as the donation Msg service
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&msgServiceDesc, srv)
+}
+
+var msgServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Donate", Handler: msgHandler("Donate", MsgServer.Donate)},
+		{MethodName: "BatchDonate", Handler: msgHandler("BatchDonate", MsgServer.BatchDonate)},
+		{MethodName: "Withdraw", Handler: msgHandler("Withdraw", MsgServer.Withdraw)},
+		{MethodName: "Distribute", Handler: msgHandler("Distribute", MsgServer.Distribute)},
+		{MethodName: "SetBeneficiaries", Handler: msgHandler("SetBeneficiaries", MsgServer.SetBeneficiaries)},
+		{MethodName: "RegisterOrganization", Handler: msgHandler("RegisterOrganization", MsgServer.RegisterOrganization)},
+		{MethodName: "SetOrganizationStatus", Handler: msgHandler("SetOrganizationStatus", MsgServer.SetOrganizationStatus)},
+		{MethodName: "Pause", Handler: msgHandler("Pause", MsgServer.Pause)},
+		{MethodName: "Unpause", Handler: msgHandler("Unpause", MsgServer.Unpause)},
+		{MethodName: "UpdateDonorList", Handler: msgHandler("UpdateDonorList", MsgServer.UpdateDonorList)},
+		{MethodName: "SetAllowlistMode", Handler: msgHandler("SetAllowlistMode", MsgServer.SetAllowlistMode)},
+		{MethodName: "SweepDust", Handler: msgHandler("SweepDust", MsgServer.SweepDust)},
+		{MethodName: "DelegateFunds", Handler: msgHandler("DelegateFunds", MsgServer.DelegateFunds)},
+		{MethodName: "UndelegateFunds", Handler: msgHandler("UndelegateFunds", MsgServer.UndelegateFunds)},
+		{MethodName: "ClaimStakingRewards", Handler: msgHandler("ClaimStakingRewards", MsgServer.ClaimStakingRewards)},
+		{MethodName: "BoostCampaign", Handler: msgHandler("BoostCampaign", MsgServer.BoostCampaign)},
+		{MethodName: "SetVestingSchedule", Handler: msgHandler("SetVestingSchedule", MsgServer.SetVestingSchedule)},
+		{MethodName: "CreateChallengeMatch", Handler: msgHandler("CreateChallengeMatch", MsgServer.CreateChallengeMatch)},
+		{MethodName: "UpdateDonationLimits", Handler: msgHandler("UpdateDonationLimits", MsgServer.UpdateDonationLimits)},
+		{MethodName: "SetCircuitBreaker", Handler: msgHandler("SetCircuitBreaker", MsgServer.SetCircuitBreaker)},
+		{MethodName: "SnapshotDonors", Handler: msgHandler("SnapshotDonors", MsgServer.SnapshotDonors)},
+		{MethodName: "FundRewardPool", Handler: msgHandler("FundRewardPool", MsgServer.FundRewardPool)},
+		{MethodName: "DistributeRewards", Handler: msgHandler("DistributeRewards", MsgServer.DistributeRewards)},
+		{MethodName: "ClaimReward", Handler: msgHandler("ClaimReward", MsgServer.ClaimReward)},
+		{MethodName: "RegisterRemoteDonationAccount", Handler: msgHandler("RegisterRemoteDonationAccount", MsgServer.RegisterRemoteDonationAccount)},
+		{MethodName: "ForwardDonation", Handler: msgHandler("ForwardDonation", MsgServer.ForwardDonation)},
+		{MethodName: "RetryForwardDonation", Handler: msgHandler("RetryForwardDonation", MsgServer.RetryForwardDonation)},
+		{MethodName: "Clawback", Handler: msgHandler("Clawback", MsgServer.Clawback)},
+		{MethodName: "ClaimCampaignRefund", Handler: msgHandler("ClaimCampaignRefund", MsgServer.ClaimCampaignRefund)},
+		{MethodName: "MatchDonations", Handler: msgHandler("MatchDonations", MsgServer.MatchDonations)},
+		{MethodName: "Refund", Handler: msgHandler("Refund", MsgServer.Refund)},
+		{MethodName: "ArchiveCampaign", Handler: msgHandler("ArchiveCampaign", MsgServer.ArchiveCampaign)},
+		{MethodName: "UnarchiveCampaign", Handler: msgHandler("UnarchiveCampaign", MsgServer.UnarchiveCampaign)},
+		{MethodName: "TransferAdmin", Handler: msgHandler("TransferAdmin", MsgServer.TransferAdmin)},
+		{MethodName: "AcceptAdmin", Handler: msgHandler("AcceptAdmin", MsgServer.AcceptAdmin)},
+		{MethodName: "GrantRole", Handler: msgHandler("GrantRole", MsgServer.GrantRole)},
+		{MethodName: "RevokeRole", Handler: msgHandler("RevokeRole", MsgServer.RevokeRole)},
+		{MethodName: "ScheduleEmergencyWithdraw", Handler: msgHandler("ScheduleEmergencyWithdraw", MsgServer.ScheduleEmergencyWithdraw)},
+		{MethodName: "EmergencyWithdraw", Handler: msgHandler("EmergencyWithdraw", MsgServer.EmergencyWithdraw)},
+		{MethodName: "CancelEmergencyWithdraw", Handler: msgHandler("CancelEmergencyWithdraw", MsgServer.CancelEmergencyWithdraw)},
+		{MethodName: "UpdateParams", Handler: msgHandler("UpdateParams", MsgServer.UpdateParams)},
+		{MethodName: "CancelParamsChange", Handler: msgHandler("CancelParamsChange", MsgServer.CancelParamsChange)},
+		{MethodName: "CreateCampaign", Handler: msgHandler("CreateCampaign", MsgServer.CreateCampaign)},
+		{MethodName: "CreateTeam", Handler: msgHandler("CreateTeam", MsgServer.CreateTeam)},
+		{MethodName: "JoinTeam", Handler: msgHandler("JoinTeam", MsgServer.JoinTeam)},
+		{MethodName: "LeaveTeam", Handler: msgHandler("LeaveTeam", MsgServer.LeaveTeam)},
+		{MethodName: "FundMatchingPool", Handler: msgHandler("FundMatchingPool", MsgServer.FundMatchingPool)},
+		{MethodName: "ConfigureMatchingPool", Handler: msgHandler("ConfigureMatchingPool", MsgServer.ConfigureMatchingPool)},
+		{MethodName: "PostCampaignUpdate", Handler: msgHandler("PostCampaignUpdate", MsgServer.PostCampaignUpdate)},
+		{MethodName: "SetCampaignExtensionPolicy", Handler: msgHandler("SetCampaignExtensionPolicy", MsgServer.SetCampaignExtensionPolicy)},
+		{MethodName: "RecordIdentityAttestation", Handler: msgHandler("RecordIdentityAttestation", MsgServer.RecordIdentityAttestation)},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "donation/v1/tx.proto",
+}
+
+// msgHandler adapts a MsgServer method to a gRPC unary handler. Msgs the
+// circuit breaker has disabled are rejected before reaching the server.
+func msgHandler[Req, Res any](
+	method string,
+	serve func(MsgServer, context.Context, *Req) (*Res, error),
+) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	fullMethod := "/donation.v1.Msg/" + method
+	typeURL := msgTypeURLPrefix + method
+
+	call := func(srv MsgServer, ctx context.Context, req *Req) (*Res, error) {
+		if breaker, ok := srv.(interface {
+			checkCircuit(context.Context, string) error
+		}); ok {
+			if err := breaker.checkCircuit(ctx, typeURL); err != nil {
+				return nil, err
+			}
+		}
+		return serve(srv, ctx, req)
+	}
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := new(Req)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(MsgServer), ctx, in)
+		}
+
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv.(MsgServer), ctx, req.(*Req))
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
@@ -0,0 +1,1618 @@
+package donation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// Msg types mirroring the Msg service in proto/donation/v1/tx.proto
+
+// MsgServer is the donation Msg service
+type MsgServer interface {
+	Donate(context.Context, *MsgDonate) (*MsgDonateResponse, error)
+	BatchDonate(context.Context, *MsgBatchDonate) (*MsgBatchDonateResponse, error)
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	Distribute(context.Context, *MsgDistribute) (*MsgDistributeResponse, error)
+	SetBeneficiaries(context.Context, *MsgSetBeneficiaries) (*MsgSetBeneficiariesResponse, error)
+	RegisterOrganization(context.Context, *MsgRegisterOrganization) (*MsgRegisterOrganizationResponse, error)
+	SetOrganizationStatus(context.Context, *MsgSetOrganizationStatus) (*MsgSetOrganizationStatusResponse, error)
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	UpdateDonorList(context.Context, *MsgUpdateDonorList) (*MsgUpdateDonorListResponse, error)
+	SetAllowlistMode(context.Context, *MsgSetAllowlistMode) (*MsgSetAllowlistModeResponse, error)
+	SweepDust(context.Context, *MsgSweepDust) (*MsgSweepDustResponse, error)
+	DelegateFunds(context.Context, *MsgDelegateFunds) (*MsgDelegateFundsResponse, error)
+	UndelegateFunds(context.Context, *MsgUndelegateFunds) (*MsgUndelegateFundsResponse, error)
+	ClaimStakingRewards(context.Context, *MsgClaimStakingRewards) (*MsgClaimStakingRewardsResponse, error)
+	BoostCampaign(context.Context, *MsgBoostCampaign) (*MsgBoostCampaignResponse, error)
+	SetVestingSchedule(context.Context, *MsgSetVestingSchedule) (*MsgSetVestingScheduleResponse, error)
+	CreateChallengeMatch(context.Context, *MsgCreateChallengeMatch) (*MsgCreateChallengeMatchResponse, error)
+	UpdateDonationLimits(context.Context, *MsgUpdateDonationLimits) (*MsgUpdateDonationLimitsResponse, error)
+	SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error)
+	SnapshotDonors(context.Context, *MsgSnapshotDonors) (*MsgSnapshotDonorsResponse, error)
+	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
+	DistributeRewards(context.Context, *MsgDistributeRewards) (*MsgDistributeRewardsResponse, error)
+	ClaimReward(context.Context, *MsgClaimReward) (*MsgClaimRewardResponse, error)
+	RegisterRemoteDonationAccount(context.Context, *MsgRegisterRemoteDonationAccount) (*MsgRegisterRemoteDonationAccountResponse, error)
+	ForwardDonation(context.Context, *MsgForwardDonation) (*MsgForwardDonationResponse, error)
+	RetryForwardDonation(context.Context, *MsgRetryForwardDonation) (*MsgRetryForwardDonationResponse, error)
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	ClaimCampaignRefund(context.Context, *MsgClaimCampaignRefund) (*MsgClaimCampaignRefundResponse, error)
+	MatchDonations(context.Context, *MsgMatchDonations) (*MsgMatchDonationsResponse, error)
+	Refund(context.Context, *MsgRefund) (*MsgRefundResponse, error)
+	ArchiveCampaign(context.Context, *MsgArchiveCampaign) (*MsgArchiveCampaignResponse, error)
+	UnarchiveCampaign(context.Context, *MsgUnarchiveCampaign) (*MsgUnarchiveCampaignResponse, error)
+	TransferAdmin(context.Context, *MsgTransferAdmin) (*MsgTransferAdminResponse, error)
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	ScheduleEmergencyWithdraw(context.Context, *MsgScheduleEmergencyWithdraw) (*MsgScheduleEmergencyWithdrawResponse, error)
+	EmergencyWithdraw(context.Context, *MsgEmergencyWithdraw) (*MsgEmergencyWithdrawResponse, error)
+	CancelEmergencyWithdraw(context.Context, *MsgCancelEmergencyWithdraw) (*MsgCancelEmergencyWithdrawResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error)
+	CreateCampaign(context.Context, *MsgCreateCampaign) (*MsgCreateCampaignResponse, error)
+	CreateTeam(context.Context, *MsgCreateTeam) (*MsgCreateTeamResponse, error)
+	JoinTeam(context.Context, *MsgJoinTeam) (*MsgJoinTeamResponse, error)
+	LeaveTeam(context.Context, *MsgLeaveTeam) (*MsgLeaveTeamResponse, error)
+	FundMatchingPool(context.Context, *MsgFundMatchingPool) (*MsgFundMatchingPoolResponse, error)
+	ConfigureMatchingPool(context.Context, *MsgConfigureMatchingPool) (*MsgConfigureMatchingPoolResponse, error)
+	PostCampaignUpdate(context.Context, *MsgPostCampaignUpdate) (*MsgPostCampaignUpdateResponse, error)
+	SetCampaignExtensionPolicy(context.Context, *MsgSetCampaignExtensionPolicy) (*MsgSetCampaignExtensionPolicyResponse, error)
+	RecordIdentityAttestation(context.Context, *MsgRecordIdentityAttestation) (*MsgRecordIdentityAttestationResponse, error)
+}
+
+var (
+	_ sdk.Msg = &MsgDonate{}
+	_ sdk.Msg = &MsgBatchDonate{}
+	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgDistribute{}
+	_ sdk.Msg = &MsgSetBeneficiaries{}
+	_ sdk.Msg = &MsgRegisterOrganization{}
+	_ sdk.Msg = &MsgSetOrganizationStatus{}
+	_ sdk.Msg = &MsgPause{}
+	_ sdk.Msg = &MsgUnpause{}
+	_ sdk.Msg = &MsgUpdateDonorList{}
+	_ sdk.Msg = &MsgSetAllowlistMode{}
+	_ sdk.Msg = &MsgSweepDust{}
+	_ sdk.Msg = &MsgDelegateFunds{}
+	_ sdk.Msg = &MsgUndelegateFunds{}
+	_ sdk.Msg = &MsgClaimStakingRewards{}
+	_ sdk.Msg = &MsgBoostCampaign{}
+	_ sdk.Msg = &MsgSetVestingSchedule{}
+	_ sdk.Msg = &MsgCreateChallengeMatch{}
+	_ sdk.Msg = &MsgUpdateDonationLimits{}
+	_ sdk.Msg = &MsgSetCircuitBreaker{}
+	_ sdk.Msg = &MsgSnapshotDonors{}
+	_ sdk.Msg = &MsgFundRewardPool{}
+	_ sdk.Msg = &MsgDistributeRewards{}
+	_ sdk.Msg = &MsgClaimReward{}
+	_ sdk.Msg = &MsgRegisterRemoteDonationAccount{}
+	_ sdk.Msg = &MsgForwardDonation{}
+	_ sdk.Msg = &MsgRetryForwardDonation{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgClaimCampaignRefund{}
+	_ sdk.Msg = &MsgMatchDonations{}
+	_ sdk.Msg = &MsgRefund{}
+	_ sdk.Msg = &MsgArchiveCampaign{}
+	_ sdk.Msg = &MsgUnarchiveCampaign{}
+	_ sdk.Msg = &MsgTransferAdmin{}
+	_ sdk.Msg = &MsgAcceptAdmin{}
+	_ sdk.Msg = &MsgGrantRole{}
+	_ sdk.Msg = &MsgRevokeRole{}
+	_ sdk.Msg = &MsgScheduleEmergencyWithdraw{}
+	_ sdk.Msg = &MsgEmergencyWithdraw{}
+	_ sdk.Msg = &MsgCancelEmergencyWithdraw{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCancelParamsChange{}
+	_ sdk.Msg = &MsgCreateCampaign{}
+	_ sdk.Msg = &MsgCreateTeam{}
+	_ sdk.Msg = &MsgJoinTeam{}
+	_ sdk.Msg = &MsgLeaveTeam{}
+	_ sdk.Msg = &MsgFundMatchingPool{}
+	_ sdk.Msg = &MsgConfigureMatchingPool{}
+	_ sdk.Msg = &MsgPostCampaignUpdate{}
+	_ sdk.Msg = &MsgSetCampaignExtensionPolicy{}
+	_ sdk.Msg = &MsgRecordIdentityAttestation{}
+)
+
+// MsgDonate donates coins from the donor's account
+type MsgDonate struct {
+	Donor  string
+	Amount sdk.Coins
+	Memo   string // optional, at most Params.MaxMemoLength bytes
+
+	// Anonymous keeps the donor's address out of events and public queries
+	Anonymous bool
+
+	// CampaignID directs the donation to a campaign; 0 donates to the
+	// module without one
+	CampaignID uint64
+}
+
+// MsgDonateResponse is the response to MsgDonate
+type MsgDonateResponse struct {
+	ReceiptID string // see DonationReceiptID
+
+	// EscrowID is set instead of ReceiptID when the donation is held in
+	// escrow; the receipt is issued on release
+	EscrowID uint64
+}
+
+// MsgBatchDonate splits one transfer across several campaigns and denoms.
+// The memo and anonymous flag apply to every entry.
+type MsgBatchDonate struct {
+	Donor     string
+	Entries   []BatchDonationEntry
+	Memo      string
+	Anonymous bool
+}
+
+// MsgBatchDonateResponse is the response to MsgBatchDonate
+type MsgBatchDonateResponse struct{}
+
+// MsgWithdraw withdraws donated funds to a recipient
+type MsgWithdraw struct {
+	Sender    string
+	Amount    sdk.Coins
+	Recipient string // empty splits the amount across the beneficiaries
+}
+
+// MsgWithdrawResponse is the response to MsgWithdraw
+type MsgWithdrawResponse struct{}
+
+// MsgDistribute withdraws donated funds split across the beneficiaries
+type MsgDistribute struct {
+	Sender string
+	Amount sdk.Coins
+}
+
+// MsgDistributeResponse is the response to MsgDistribute
+type MsgDistributeResponse struct{}
+
+// MsgSetBeneficiaries replaces the weighted beneficiaries
+type MsgSetBeneficiaries struct {
+	Sender        string
+	Beneficiaries []Beneficiary
+}
+
+// MsgSetBeneficiariesResponse is the response to MsgSetBeneficiaries
+type MsgSetBeneficiariesResponse struct{}
+
+// MsgRegisterOrganization adds or updates a beneficiary registry entry;
+// sent by governance or an ADMIN
+type MsgRegisterOrganization struct {
+	Sender      string
+	Address     string
+	Name        string
+	MetadataURI string
+}
+
+// MsgRegisterOrganizationResponse is the response to MsgRegisterOrganization
+type MsgRegisterOrganizationResponse struct{}
+
+// MsgSetOrganizationStatus verifies or revokes a registered organization;
+// sent by governance or an ADMIN
+type MsgSetOrganizationStatus struct {
+	Sender  string
+	Address string
+	Status  string // PENDING, VERIFIED or REVOKED
+}
+
+// MsgSetOrganizationStatusResponse is the response to MsgSetOrganizationStatus
+type MsgSetOrganizationStatusResponse struct{}
+
+// MsgPause pauses donations
+type MsgPause struct {
+	Sender        string
+	Reason        string // optional, at most MaxPauseReasonLength bytes
+	UnpauseHeight int64  // optional block at which donations resume
+}
+
+// MsgPauseResponse is the response to MsgPause
+type MsgPauseResponse struct{}
+
+// MsgUnpause resumes donations
+type MsgUnpause struct {
+	Sender string
+}
+
+// MsgUnpauseResponse is the response to MsgUnpause
+type MsgUnpauseResponse struct{}
+
+// MsgUpdateDonorList adds and removes addresses from the ALLOW or BLOCK list
+type MsgUpdateDonorList struct {
+	Sender string
+	List   string
+	Add    []string
+	Remove []string
+}
+
+// MsgUpdateDonorListResponse is the response to MsgUpdateDonorList
+type MsgUpdateDonorListResponse struct{}
+
+// MsgSetAllowlistMode turns allowlist mode on or off
+type MsgSetAllowlistMode struct {
+	Sender  string
+	Enabled bool
+}
+
+// MsgSetAllowlistModeResponse is the response to MsgSetAllowlistMode
+type MsgSetAllowlistModeResponse struct{}
+
+// MsgSweepDust sends module account dust to the community pool
+type MsgSweepDust struct {
+	Sender string
+}
+
+// MsgSweepDustResponse is the response to MsgSweepDust
+type MsgSweepDustResponse struct {
+	Swept sdk.Coins
+}
+
+// MsgDelegateFunds delegates idle donation funds to a validator
+type MsgDelegateFunds struct {
+	Sender    string
+	Validator string
+	Amount    sdk.Coin
+}
+
+// MsgDelegateFundsResponse is the response to MsgDelegateFunds
+type MsgDelegateFundsResponse struct{}
+
+// MsgUndelegateFunds starts unbonding delegated donation funds
+type MsgUndelegateFunds struct {
+	Sender    string
+	Validator string
+	Amount    sdk.Coin
+}
+
+// MsgUndelegateFundsResponse is the response to MsgUndelegateFunds
+type MsgUndelegateFundsResponse struct {
+	CompletionTime int64 // unix seconds
+}
+
+// MsgClaimStakingRewards claims the rewards of the module's delegations
+// into the donations
+type MsgClaimStakingRewards struct {
+	Sender string
+}
+
+// MsgClaimStakingRewardsResponse is the response to MsgClaimStakingRewards
+type MsgClaimStakingRewardsResponse struct {
+	Rewards sdk.Coins
+}
+
+// MsgBoostCampaign buys a time-limited ranking boost for a campaign
+type MsgBoostCampaign struct {
+	Sponsor    string
+	CampaignID uint64
+	Amount     sdk.Coin
+	Duration   int64 // seconds
+}
+
+// MsgBoostCampaignResponse is the response to MsgBoostCampaign
+type MsgBoostCampaignResponse struct {
+	BoostID uint64
+	Expiry  int64
+}
+
+// MsgSetVestingSchedule sets or cancels (zero total) a beneficiary's
+// vesting schedule
+type MsgSetVestingSchedule struct {
+	Sender      string
+	Beneficiary string
+	Total       sdk.Coins
+	Cliff       int64 // seconds
+	Duration    int64 // seconds
+}
+
+// MsgSetVestingScheduleResponse is the response to MsgSetVestingSchedule
+type MsgSetVestingScheduleResponse struct{}
+
+// MsgCreateChallengeMatch escrows a sponsor match that is donated to a
+// campaign if it raises Target before Deadline
+type MsgCreateChallengeMatch struct {
+	Sponsor    string
+	CampaignID uint64
+	Target     sdk.Coin
+	Deadline   int64 // unix seconds
+	Match      sdk.Coins
+}
+
+// MsgCreateChallengeMatchResponse is the response to MsgCreateChallengeMatch
+type MsgCreateChallengeMatchResponse struct {
+	ID uint64
+}
+
+// MsgUpdateDonationLimits sets the min and max donation of the listed
+// denoms; sent by an ADMIN
+type MsgUpdateDonationLimits struct {
+	Sender      string
+	MinDonation sdk.Coins
+	MaxDonation sdk.Coins // same denoms as MinDonation, each above its min
+}
+
+// MsgUpdateDonationLimitsResponse is the response to MsgUpdateDonationLimits
+type MsgUpdateDonationLimitsResponse struct{}
+
+// MsgSetCircuitBreaker disables or re-enables the listed donation Msgs;
+// sent by governance or an ADMIN
+type MsgSetCircuitBreaker struct {
+	Sender   string
+	TypeURLs []string // e.g. /donation.v1.MsgWithdraw
+	Disabled bool
+}
+
+// MsgSetCircuitBreakerResponse is the response to MsgSetCircuitBreaker
+type MsgSetCircuitBreakerResponse struct{}
+
+// MsgSnapshotDonors schedules a Merkle snapshot of the donor set; sent by
+// governance or an ADMIN
+type MsgSnapshotDonors struct {
+	Sender string
+	Height int64 // block to snapshot at the end of; 0 for the current one
+}
+
+// MsgSnapshotDonorsResponse is the response to MsgSnapshotDonors
+type MsgSnapshotDonorsResponse struct {
+	SnapshotID uint64
+}
+
+// MsgFundRewardPool adds the sponsor's coins to the donor reward pool
+type MsgFundRewardPool struct {
+	Sponsor string
+	Amount  sdk.Coins
+}
+
+// MsgFundRewardPoolResponse is the response to MsgFundRewardPool
+type MsgFundRewardPoolResponse struct{}
+
+// MsgDistributeRewards allocates part of the reward pool to donors; sent
+// by an ADMIN
+type MsgDistributeRewards struct {
+	Sender          string
+	Amount          sdk.Coins
+	ClaimPeriod     int64 // seconds donors have to claim
+	TierMultipliers RewardTierMultipliers
+}
+
+// MsgDistributeRewardsResponse is the response to MsgDistributeRewards
+type MsgDistributeRewardsResponse struct {
+	DistributionID uint64
+}
+
+// MsgClaimReward claims the donor's reward from a distribution
+type MsgClaimReward struct {
+	Donor          string
+	DistributionID uint64
+}
+
+// MsgClaimRewardResponse is the response to MsgClaimReward
+type MsgClaimRewardResponse struct {
+	Amount sdk.Coins
+}
+
+// MsgRegisterRemoteDonationAccount opens the module's interchain account on
+// a connection; sent by governance or an ADMIN
+type MsgRegisterRemoteDonationAccount struct {
+	Sender       string
+	ConnectionID string
+}
+
+// MsgRegisterRemoteDonationAccountResponse is the response to
+// MsgRegisterRemoteDonationAccount
+type MsgRegisterRemoteDonationAccountResponse struct{}
+
+// MsgForwardDonation forwards withdrawable funds to a recipient on another
+// chain through the module's interchain account; approved like a withdrawal
+type MsgForwardDonation struct {
+	Sender          string
+	ConnectionID    string
+	TransferChannel string
+	Amount          sdk.Coin
+
+	// Recipient is an address on the remote chain; empty donates to the
+	// remote chain's donation module with Memo
+	Recipient string
+	Memo      string
+}
+
+// MsgForwardDonationResponse is the response to MsgForwardDonation
+type MsgForwardDonationResponse struct {
+	ForwardID uint64
+}
+
+// MsgRetryForwardDonation resumes a failed forwarded donation
+type MsgRetryForwardDonation struct {
+	Sender    string
+	ForwardID uint64
+}
+
+// MsgRetryForwardDonationResponse is the response to MsgRetryForwardDonation
+type MsgRetryForwardDonationResponse struct{}
+
+// MsgClawback returns an escrowed donation to its donor before its release
+// height
+type MsgClawback struct {
+	Donor    string
+	EscrowID uint64
+}
+
+// MsgClawbackResponse is the response to MsgClawback
+type MsgClawbackResponse struct {
+	Amount sdk.Coins
+}
+
+// MsgClaimCampaignRefund returns the donor's contribution to a failed
+// campaign
+type MsgClaimCampaignRefund struct {
+	Donor      string
+	CampaignID uint64
+}
+
+// MsgClaimCampaignRefundResponse is the response to MsgClaimCampaignRefund
+type MsgClaimCampaignRefundResponse struct {
+	Amount sdk.Coins
+}
+
+// MsgMatchDonations matches the donations recorded in a block range at a
+// ratio, funded from the sponsor's account
+type MsgMatchDonations struct {
+	Sponsor     string
+	StartHeight int64
+	EndHeight   int64
+	Ratio       sdk.Dec
+}
+
+// MsgMatchDonationsResponse is the response to MsgMatchDonations
+type MsgMatchDonationsResponse struct {
+	Matched   sdk.Coins
+	Donations uint64
+}
+
+// MsgRefund returns a recorded donation, less its fee and burned share, to
+// its donor and reverses its accounting. The sender needs the approval of a
+// withdrawal of the refunded amount under the withdrawal bands.
+type MsgRefund struct {
+	Sender     string
+	DonationID uint64
+}
+
+// MsgRefundResponse is the response to MsgRefund
+type MsgRefundResponse struct {
+	Amount sdk.Coins
+}
+
+// MsgArchiveCampaign freezes a finished campaign. The sender must be the
+// campaign's creator or the admin.
+type MsgArchiveCampaign struct {
+	Sender     string
+	CampaignID uint64
+}
+
+// MsgArchiveCampaignResponse is the response to MsgArchiveCampaign
+type MsgArchiveCampaignResponse struct{}
+
+// MsgUnarchiveCampaign reopens an archived campaign. It is signed by the
+// module authority, i.e. executed through a governance proposal.
+type MsgUnarchiveCampaign struct {
+	Authority  string
+	CampaignID uint64
+}
+
+// MsgUnarchiveCampaignResponse is the response to MsgUnarchiveCampaign
+type MsgUnarchiveCampaignResponse struct{}
+
+// MsgTransferAdmin proposes a new admin, who must accept with
+// MsgAcceptAdmin. Proposing the current admin cancels a pending transfer.
+type MsgTransferAdmin struct {
+	Admin    string
+	NewAdmin string
+}
+
+// MsgTransferAdminResponse is the response to MsgTransferAdmin
+type MsgTransferAdminResponse struct{}
+
+// MsgAcceptAdmin completes a pending admin transfer; the sender must be the
+// proposed admin
+type MsgAcceptAdmin struct {
+	Sender string
+}
+
+// MsgAcceptAdminResponse is the response to MsgAcceptAdmin
+type MsgAcceptAdminResponse struct{}
+
+// MsgGrantRole grants a role to an address. The sender needs the ADMIN role.
+type MsgGrantRole struct {
+	Sender  string
+	Address string
+	Role    Role
+}
+
+// MsgGrantRoleResponse is the response to MsgGrantRole
+type MsgGrantRoleResponse struct{}
+
+// MsgRevokeRole revokes a role from an address. The sender needs the ADMIN role.
+type MsgRevokeRole struct {
+	Sender  string
+	Address string
+	Role    Role
+}
+
+// MsgRevokeRoleResponse is the response to MsgRevokeRole
+type MsgRevokeRoleResponse struct{}
+
+// MsgScheduleEmergencyWithdraw starts the timelock for withdrawing all
+// withdrawable funds to the recipient. The sender needs the WITHDRAWER role.
+type MsgScheduleEmergencyWithdraw struct {
+	Sender    string
+	Recipient string
+}
+
+// MsgScheduleEmergencyWithdrawResponse is the response to
+// MsgScheduleEmergencyWithdraw
+type MsgScheduleEmergencyWithdrawResponse struct {
+	ExecutableHeight int64
+}
+
+// MsgEmergencyWithdraw executes a scheduled emergency withdrawal once its
+// timelock has passed. The recipient must match the scheduled one.
+type MsgEmergencyWithdraw struct {
+	Sender    string
+	Recipient string
+}
+
+// MsgEmergencyWithdrawResponse is the response to MsgEmergencyWithdraw
+type MsgEmergencyWithdrawResponse struct {
+	Amount sdk.Coins
+}
+
+// MsgCancelEmergencyWithdraw cancels a scheduled emergency withdrawal. The
+// sender needs the WITHDRAWER role.
+type MsgCancelEmergencyWithdraw struct {
+	Sender string
+}
+
+// MsgCancelEmergencyWithdrawResponse is the response to
+// MsgCancelEmergencyWithdraw
+type MsgCancelEmergencyWithdrawResponse struct{}
+
+// MsgUpdateParams announces new module parameters, which take effect
+// Params.ParamChangeDelay blocks later. It is signed by the module
+// authority, i.e. executed through a governance proposal.
+type MsgUpdateParams struct {
+	Authority string
+	Params    Params
+}
+
+// MsgUpdateParamsResponse is the response to MsgUpdateParams
+type MsgUpdateParamsResponse struct{}
+
+// MsgCancelParamsChange drops the pending param change; governance only
+type MsgCancelParamsChange struct {
+	Authority string
+}
+
+// MsgCancelParamsChangeResponse is the response to MsgCancelParamsChange
+type MsgCancelParamsChangeResponse struct{}
+
+// MsgCreateCampaign registers a new campaign raising funds for the
+// beneficiary
+type MsgCreateCampaign struct {
+	Creator     string
+	Title       string
+	Beneficiary string
+	Goal        sdk.Coins // empty for no goal
+	Deadline    int64     // unix seconds, 0 for open-ended
+
+	// AcceptedDenoms restricts the campaign to these denoms; empty accepts
+	// any denom with a donation limit
+	AcceptedDenoms []string
+}
+
+// MsgCreateCampaignResponse is the response to MsgCreateCampaign
+type MsgCreateCampaignResponse struct {
+	CampaignID uint64
+}
+
+// MsgCreateTeam registers a team, optionally tied to a campaign, with the
+// creator as its first member
+type MsgCreateTeam struct {
+	Creator    string
+	Name       string
+	CampaignID uint64 // 0 for a team not tied to a campaign
+}
+
+// MsgCreateTeamResponse is the response to MsgCreateTeam
+type MsgCreateTeamResponse struct {
+	TeamID uint64
+}
+
+// MsgJoinTeam adds the sender to a team
+type MsgJoinTeam struct {
+	Member string
+	TeamID uint64
+}
+
+// MsgJoinTeamResponse is the response to MsgJoinTeam
+type MsgJoinTeamResponse struct{}
+
+// MsgLeaveTeam removes the sender from their team
+type MsgLeaveTeam struct {
+	Member string
+}
+
+// MsgLeaveTeamResponse is the response to MsgLeaveTeam
+type MsgLeaveTeamResponse struct{}
+
+// MsgFundMatchingPool moves coins from the sponsor into the matching pool
+type MsgFundMatchingPool struct {
+	Sponsor string
+	Amount  sdk.Coins
+}
+
+// MsgFundMatchingPoolResponse is the response to MsgFundMatchingPool
+type MsgFundMatchingPoolResponse struct{}
+
+// MsgConfigureMatchingPool sets the match ratio and (de)activates matching.
+// It is signed by the module authority, i.e. executed through a governance
+// proposal.
+type MsgConfigureMatchingPool struct {
+	Authority string
+	Ratio     sdk.Dec // matched amount per donated unit, e.g. 1.0 for 1:1
+	Active    bool
+}
+
+// MsgConfigureMatchingPoolResponse is the response to
+// MsgConfigureMatchingPool
+type MsgConfigureMatchingPoolResponse struct{}
+
+// MsgPostCampaignUpdate appends an update to a campaign's feed. The author
+// must be the campaign's creator or the admin.
+type MsgPostCampaignUpdate struct {
+	Author      string
+	CampaignID  uint64
+	Title       string // at most MaxCampaignUpdateTitleLength bytes
+	URI         string // where the content lives off-chain
+	ContentHash []byte // sha256 of the content
+}
+
+// MsgPostCampaignUpdateResponse is the response to MsgPostCampaignUpdate
+type MsgPostCampaignUpdateResponse struct {
+	Index uint64
+}
+
+// MsgSetCampaignExtensionPolicy sets a campaign's extension policy; an
+// unset policy removes it. The sender must be the campaign's creator or the
+// module authority.
+type MsgSetCampaignExtensionPolicy struct {
+	Sender     string
+	CampaignID uint64
+	Policy     ExtensionPolicy
+}
+
+// MsgSetCampaignExtensionPolicyResponse is the response to
+// MsgSetCampaignExtensionPolicy
+type MsgSetCampaignExtensionPolicyResponse struct{}
+
+// MsgRecordIdentityAttestation records the reference to a credential
+// verified off-chain for a donor, replacing any previous one. The attester
+// needs the ATTESTER role.
+type MsgRecordIdentityAttestation struct {
+	Attester       string
+	Donor          string
+	Issuer         string // issuer DID
+	CredentialHash string // hex sha256 of the presented credential
+	ExpiresAt      int64  // unix seconds, 0 if the credential does not expire
+}
+
+// MsgRecordIdentityAttestationResponse is the response to
+// MsgRecordIdentityAttestation
+type MsgRecordIdentityAttestationResponse struct{}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDonate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid donation amount")
+	}
+	if !utf8.ValidString(m.Memo) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "memo must be valid UTF-8")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDonate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Donor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBatchDonate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if len(m.Entries) == 0 || len(m.Entries) > MaxBatchDonationEntries {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch donation needs 1 to %d entries", MaxBatchDonationEntries)
+	}
+	for i, entry := range m.Entries {
+		if !entry.Amount.IsValid() || entry.Amount.IsZero() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount in batch entry %d", i)
+		}
+	}
+	if !utf8.ValidString(m.Memo) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "memo must be valid UTF-8")
+	}
+	return nil
+}
+
+// Total returns the sum of the entry amounts
+func (m *MsgBatchDonate) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, entry := range m.Entries {
+		total = total.Add(entry.Amount...)
+	}
+	return total
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBatchDonate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Donor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid withdrawal amount")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDistribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid distribution amount")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDistribute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetBeneficiaries) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := ValidateBeneficiaries(m.Beneficiaries); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetBeneficiaries) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !utf8.ValidString(m.Reason) || len(m.Reason) > MaxPauseReasonLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pause reason must be UTF-8, at most %d bytes", MaxPauseReasonLength)
+	}
+	if m.UnpauseHeight < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "negative unpause height")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgPause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUnpause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUnpause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUpdateDonorList) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := DonorListFromString(m.List); err != nil {
+		return err
+	}
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no addresses to add or remove")
+	}
+	for _, addr := range append(append([]string{}, m.Add...), m.Remove...) {
+		if err := validateListAddress(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUpdateDonorList) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetAllowlistMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetAllowlistMode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRegisterOrganization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	org := Organization{Address: m.Address, Name: m.Name, MetadataURI: m.MetadataURI, Status: StatusPending}
+	if err := org.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRegisterOrganization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetOrganizationStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := VerificationStatusFromString(m.Status); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetOrganizationStatus) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSweepDust) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSweepDust) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDelegateFunds) ValidateBasic() error {
+	return validateStakingMsg(m.Sender, m.Validator, m.Amount)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDelegateFunds) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUndelegateFunds) ValidateBasic() error {
+	return validateStakingMsg(m.Sender, m.Validator, m.Amount)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUndelegateFunds) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+func validateStakingMsg(sender, validator string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+	return nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimStakingRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimStakingRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBoostCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	if m.Duration <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "boost duration must be positive")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBoostCampaign) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sponsor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Total.IsZero() {
+		if _, err := sdk.AccAddressFromBech32(m.Beneficiary); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+		return nil
+	}
+	schedule := VestingSchedule{Beneficiary: m.Beneficiary, Total: m.Total, Cliff: m.Cliff, Duration: m.Duration}
+	if err := schedule.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCreateChallengeMatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Target.IsValid() || !m.Target.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Target.String())
+	}
+	if !m.Match.IsValid() || m.Match.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Match.String())
+	}
+	if m.Deadline <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "challenge deadline required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCreateChallengeMatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sponsor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUpdateDonationLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.MinDonation.IsZero() || !m.MinDonation.IsValid() || !m.MaxDonation.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid donation limits")
+	}
+	if _, err := DonationLimitsFromCoins(m.MinDonation, m.MaxDonation); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUpdateDonationLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return validateCircuitTypeURLs(m.TypeURLs)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSnapshotDonors) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Height < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "negative snapshot height")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSnapshotDonors) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgFundRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sponsor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDistributeRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	if m.ClaimPeriod <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "claim period must be positive")
+	}
+	if err := m.TierMultipliers.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDistributeRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.DistributionID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "distribution ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Donor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRegisterRemoteDonationAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(m.ConnectionID); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRegisterRemoteDonationAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgForwardDonation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := host.ConnectionIdentifierValidator(m.ConnectionID); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(m.TransferChannel); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	if m.Recipient != "" && m.Memo != "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a memo is only sent with donations to the remote donation module")
+	}
+	if !utf8.ValidString(m.Memo) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "memo must be valid UTF-8")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgForwardDonation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRetryForwardDonation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.ForwardID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "forward ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRetryForwardDonation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.EscrowID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "escrow ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Donor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimCampaignRefund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.CampaignID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "campaign ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimCampaignRefund) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Donor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgMatchDonations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.StartHeight <= 0 || m.StartHeight > m.EndHeight {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block range [%d, %d]", m.StartHeight, m.EndHeight)
+	}
+	if m.Ratio.IsNil() || !m.Ratio.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "match ratio must be positive")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgMatchDonations) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sponsor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRefund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.DonationID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "donation ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRefund) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgArchiveCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.CampaignID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "campaign ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgArchiveCampaign) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUnarchiveCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.CampaignID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "campaign ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUnarchiveCampaign) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgTransferAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgTransferAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Admin)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgAcceptAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgGrantRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Role.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown role %d", m.Role)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgGrantRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Role.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown role %d", m.Role)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgScheduleEmergencyWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgScheduleEmergencyWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgEmergencyWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgEmergencyWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCancelEmergencyWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCancelEmergencyWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := m.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCancelParamsChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCancelParamsChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCreateCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Beneficiary); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "campaign title required")
+	}
+	if !m.Goal.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid campaign goal")
+	}
+	if m.Deadline < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "negative deadline")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Creator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCreateTeam) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "team name required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCreateTeam) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Creator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgJoinTeam) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Member); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.TeamID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "team ID required")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgJoinTeam) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Member)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgLeaveTeam) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Member); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgLeaveTeam) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Member)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgFundMatchingPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid funding amount")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgFundMatchingPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sponsor)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgConfigureMatchingPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Ratio.IsNil() || !m.Ratio.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "match ratio must be positive")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgConfigureMatchingPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgPostCampaignUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Author); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Title == "" || len(m.Title) > MaxCampaignUpdateTitleLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "title must be 1-%d bytes", MaxCampaignUpdateTitleLength)
+	}
+	if m.URI == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "update URI required")
+	}
+	if len(m.ContentHash) != sha256.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "content hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgPostCampaignUpdate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Author)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSetCampaignExtensionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := m.Policy.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSetCampaignExtensionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRecordIdentityAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Attester); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Donor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Issuer == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issuer required")
+	}
+	if hash, err := hex.DecodeString(m.CredentialHash); err != nil || len(hash) != sha256.Size {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "credential hash must be a hex sha256 digest")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRecordIdentityAttestation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Attester)}
+}
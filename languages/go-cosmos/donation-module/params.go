@@ -0,0 +1,336 @@
+package donation
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// Default parameter values
+const (
+	DefaultEmergencyWithdrawDelay uint64 = 100_800 // ~7 days at 6s blocks
+	DefaultAnchorEpochBlocks      uint64 = 14_400  // ~1 day at 6s blocks
+	DefaultParamChangeDelay       uint64 = 14_400  // ~1 day at 6s blocks
+	DefaultMaxMemoLength          uint64 = 256
+)
+
+// Params defines the governance-controlled module parameters
+type Params struct {
+	// EmergencyWithdrawDelay is the number of blocks between scheduling an
+	// emergency withdrawal and being able to execute it
+	EmergencyWithdrawDelay uint64
+
+	// AcceptedIBCDenoms lists the IBC vouchers accepted as donations; their
+	// base denom counts toward tiers
+	AcceptedIBCDenoms []AcceptedIBCDenom
+
+	// AnchorEpochBlocks is the epoch length in blocks at which the donor-set
+	// Merkle root is anchored; 0 disables anchoring
+	AnchorEpochBlocks uint64
+
+	// ParamChangeDelay is the announcement period in blocks between a
+	// governance param update and it taking effect; 0 applies immediately
+	ParamChangeDelay uint64
+
+	// WithdrawalBands routes withdrawals to an approval path by amount
+	WithdrawalBands WithdrawalBands
+
+	// DonationLimits bounds a single donation per denom; denoms without a
+	// limit are not accepted
+	DonationLimits DonationLimits
+
+	// TierWeights weights each denom's lifetime total when computing tiers
+	// and leaderboard scores; empty counts only the tier denom
+	TierWeights TierWeights
+
+	// USDTierThresholds, when set, computes tiers from donors' USD totals
+	// priced by the oracle keeper; unset keeps native-amount tiers
+	USDTierThresholds USDTierThresholds
+
+	// MaxMemoLength is the maximum donation memo length in bytes; 0
+	// disables memos
+	MaxMemoLength uint64
+
+	// DonorRateLimit caps each donor's donations per rolling window of
+	// blocks; unset disables the cap
+	DonorRateLimit DonorRateLimit
+
+	// DistributionSchedule pays a share of the balance to the beneficiaries
+	// at a fixed block interval; unset leaves payouts to withdrawals
+	DistributionSchedule DistributionSchedule
+
+	// StrictBeneficiaries requires withdrawal recipients and beneficiaries
+	// to be verified organizations in the registry
+	StrictBeneficiaries bool
+
+	// BurnRate is the fraction of each donation burned, in [0, 1); zero
+	// burns nothing. The module account needs the Burner permission.
+	BurnRate sdk.Dec
+
+	// DustThresholds rejects donations below the given amount per denom;
+	// module account balances below it can be swept to the community pool
+	DustThresholds sdk.Coins
+
+	// CommunityPoolFeeBps is the fee in basis points taken from every
+	// donation for the community pool, at most 1,000; zero charges nothing
+	CommunityPoolFeeBps uint32
+
+	// MaxStakedFraction caps how much of the donations held in the bond
+	// denom the admin may delegate, in [0, 1]; zero disables staking
+	MaxStakedFraction sdk.Dec
+
+	// Boosts prices sponsored campaign boosts; unset disables them
+	Boosts BoostParams
+
+	// TierDecay depreciates donors' tier contributions per epoch without a
+	// donation; unset keeps tiers on lifetime totals
+	TierDecay TierDecay
+
+	// Loyalty tracks donation streaks and loyalty points; unset disables it
+	Loyalty LoyaltyParams
+
+	// EscrowBlocks holds each donation in escrow for this many blocks, in
+	// which the donor can claw it back; 0 records donations immediately
+	EscrowBlocks uint64
+
+	// CampaignPayoutDelay is the number of blocks between a campaign
+	// succeeding at its deadline and its payout to the beneficiary
+	CampaignPayoutDelay uint64
+}
+
+// PendingParamsChange is an announced param update awaiting its effective height
+type PendingParamsChange struct {
+	Params          Params
+	Authority       string // module authority, or the ADMIN updating donation limits
+	AnnouncedHeight int64
+	EffectiveHeight int64
+}
+
+// DefaultParams returns the default module parameters
+func DefaultParams() Params {
+	return Params{
+		EmergencyWithdrawDelay: DefaultEmergencyWithdrawDelay,
+		AnchorEpochBlocks:      DefaultAnchorEpochBlocks,
+		ParamChangeDelay:       DefaultParamChangeDelay,
+		MaxMemoLength:          DefaultMaxMemoLength,
+	}
+}
+
+// Validate performs basic validation of the parameters
+func (p Params) Validate() error {
+	if p.EmergencyWithdrawDelay == 0 {
+		return fmt.Errorf("emergency withdraw delay must be positive")
+	}
+
+	seen := make(map[AcceptedIBCDenom]bool, len(p.AcceptedIBCDenoms))
+	for _, accepted := range p.AcceptedIBCDenoms {
+		trace := transfertypes.DenomTrace{Path: accepted.Path, BaseDenom: accepted.BaseDenom}
+		if err := trace.Validate(); err != nil {
+			return fmt.Errorf("invalid accepted ibc denom %s: %w", trace.GetFullDenomPath(), err)
+		}
+		if accepted.Path == "" {
+			return fmt.Errorf("accepted ibc denom %s has no trace path", accepted.BaseDenom)
+		}
+		if seen[accepted] {
+			return fmt.Errorf("duplicate accepted ibc denom %s", trace.GetFullDenomPath())
+		}
+		seen[accepted] = true
+	}
+
+	if err := p.WithdrawalBands.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.DonationLimits.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.TierWeights.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.USDTierThresholds.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.DonorRateLimit.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.DistributionSchedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBurnRate(p.BurnRate); err != nil {
+		return err
+	}
+
+	if err := validateDustThresholds(p.DustThresholds); err != nil {
+		return err
+	}
+
+	if err := validateCommunityPoolFee(p.CommunityPoolFeeBps); err != nil {
+		return err
+	}
+
+	if err := validateMaxStakedFraction(p.MaxStakedFraction); err != nil {
+		return err
+	}
+
+	if err := p.Boosts.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.TierDecay.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.Loyalty.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateMemo checks a donation memo against MaxMemoLength
+func (p Params) ValidateMemo(memo string) error {
+	if memo == "" {
+		return nil
+	}
+	if !utf8.ValidString(memo) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "memo must be valid UTF-8")
+	}
+	if uint64(len(memo)) > p.MaxMemoLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "memo longer than %d bytes", p.MaxMemoLength)
+	}
+	return nil
+}
+
+// GetParams retrieves the module parameters, falling back to the defaults
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return DefaultParams()
+	}
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams stores the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	if err := k.params.Set(ctx, params); err != nil {
+		panic(err)
+	}
+}
+
+// UpdateParams announces new module parameters; governance only. They take
+// effect ParamChangeDelay blocks later (per the current params), replacing
+// any change still pending.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params Params) error {
+	if authority != k.authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.recordAudit(ctx, authority, AuditParamsChange, map[string]interface{}{
+		"params": params,
+	}); err != nil {
+		return err
+	}
+
+	return k.scheduleParams(ctx, authority, params)
+}
+
+// scheduleParams announces params on behalf of authority, to take effect
+// ParamChangeDelay blocks later, or applies them at once when the delay is
+// zero. It replaces any change still pending.
+func (k Keeper) scheduleParams(ctx sdk.Context, authority string, params Params) error {
+	delay := k.GetParams(ctx).ParamChangeDelay
+	if delay == 0 {
+		return k.applyParams(ctx, authority, params)
+	}
+
+	pending := PendingParamsChange{
+		Params:          params,
+		Authority:       authority,
+		AnnouncedHeight: ctx.BlockHeight(),
+		EffectiveHeight: ctx.BlockHeight() + int64(delay),
+	}
+
+	if err := k.pendingParams.Set(ctx, pending); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventParamsChangeScheduled{
+		Authority:       authority,
+		EffectiveHeight: pending.EffectiveHeight,
+	})
+}
+
+// CancelParamsChange drops a pending param change; governance only
+func (k Keeper) CancelParamsChange(ctx sdk.Context, authority string) error {
+	if authority != k.authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s, got %s", k.authority, authority)
+	}
+
+	if _, found := k.GetPendingParamsChange(ctx); !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "no pending params change")
+	}
+
+	if err := k.pendingParams.Remove(ctx); err != nil {
+		return err
+	}
+
+	if err := k.recordAudit(ctx, authority, AuditParamsChangeCancelled, map[string]interface{}{}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventParamsChangeCancelled{
+		Authority: authority,
+	})
+}
+
+// GetPendingParamsChange returns the announced param change, if any
+func (k Keeper) GetPendingParamsChange(ctx sdk.Context) (PendingParamsChange, bool) {
+	pending, err := k.pendingParams.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return PendingParamsChange{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return pending, true
+}
+
+// applyPendingParams activates a pending param change once its effective
+// height is reached. Called from EndBlocker.
+func (k Keeper) applyPendingParams(ctx sdk.Context) error {
+	pending, found := k.GetPendingParamsChange(ctx)
+	if !found || ctx.BlockHeight() < pending.EffectiveHeight {
+		return nil
+	}
+
+	if err := k.pendingParams.Remove(ctx); err != nil {
+		return err
+	}
+
+	return k.applyParams(ctx, pending.Authority, pending.Params)
+}
+
+func (k Keeper) applyParams(ctx sdk.Context, authority string, params Params) error {
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&EventParamsUpdated{
+		Authority: authority,
+	})
+}
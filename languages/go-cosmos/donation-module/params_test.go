@@ -0,0 +1,112 @@
+package donation
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := setupKeeper(t)
+	f.ctx = f.ctx.WithBlockHeight(100)
+	msgServer := NewMsgServerImpl(f.k)
+	goCtx := sdk.WrapSDKContext(f.ctx)
+
+	params := f.k.GetParams(f.ctx)
+	params.CommunityPoolFeeBps = 200
+
+	if _, err := msgServer.UpdateParams(goCtx, &MsgUpdateParams{Authority: testAdmin.String(), Params: params}); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("UpdateParams by the admin: error = %v, want %v", err, sdkerrors.ErrUnauthorized)
+	}
+
+	if _, err := msgServer.UpdateParams(goCtx, &MsgUpdateParams{Authority: f.k.authority, Params: params}); err != nil {
+		t.Fatalf("UpdateParams: %v", err)
+	}
+
+	pending, found := f.k.GetPendingParamsChange(f.ctx)
+	if !found {
+		t.Fatal("params change not announced")
+	}
+	if want := int64(100 + DefaultParamChangeDelay); pending.EffectiveHeight != want {
+		t.Errorf("effective height = %d, want %d", pending.EffectiveHeight, want)
+	}
+	if bps := f.k.GetParams(f.ctx).CommunityPoolFeeBps; bps != 0 {
+		t.Errorf("announced params applied early: fee = %d bps", bps)
+	}
+
+	if _, err := msgServer.CancelParamsChange(goCtx, &MsgCancelParamsChange{Authority: testAdmin.String()}); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("CancelParamsChange by the admin: error = %v, want %v", err, sdkerrors.ErrUnauthorized)
+	}
+	if _, err := msgServer.CancelParamsChange(goCtx, &MsgCancelParamsChange{Authority: f.k.authority}); err != nil {
+		t.Fatalf("CancelParamsChange: %v", err)
+	}
+	if _, found := f.k.GetPendingParamsChange(f.ctx); found {
+		t.Error("params change still pending after cancellation")
+	}
+	if _, err := msgServer.CancelParamsChange(goCtx, &MsgCancelParamsChange{Authority: f.k.authority}); !errors.Is(err, sdkerrors.ErrNotFound) {
+		t.Fatalf("second CancelParamsChange: error = %v, want %v", err, sdkerrors.ErrNotFound)
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+
+	invalid := DefaultParams()
+	invalid.CommunityPoolFeeBps = MaxCommunityPoolFeeBps + 1
+
+	tests := []struct {
+		name  string
+		msg   MsgUpdateParams
+		valid bool
+	}{
+		{"default params", MsgUpdateParams{Authority: authority, Params: DefaultParams()}, true},
+		{"invalid authority", MsgUpdateParams{Authority: "cosmos1invalid", Params: DefaultParams()}, false},
+		{"invalid params", MsgUpdateParams{Authority: authority, Params: invalid}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid && err != nil {
+				t.Fatalf("expected a valid message, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatal("expected the message to be rejected")
+			}
+		})
+	}
+}
+
+func TestUpdateDonationLimitsAnnounced(t *testing.T) {
+	f := setupKeeper(t)
+	f.ctx = f.ctx.WithBlockHeight(100)
+
+	if err := f.k.UpdateDonationLimits(f.ctx, testAdmin.String(), uatom(50_000), uatom(200_000_000)); err != nil {
+		t.Fatalf("UpdateDonationLimits: %v", err)
+	}
+
+	if limit, _ := f.k.GetParams(f.ctx).DonationLimits.Get("uatom"); !limit.Min.Equal(sdk.NewInt(10_000)) {
+		t.Errorf("min donation = %s before the delay, want 10000", limit.Min)
+	}
+	pending, found := f.k.GetPendingParamsChange(f.ctx)
+	if !found {
+		t.Fatal("donation limits not announced")
+	}
+	if limit, _ := pending.Params.DonationLimits.Get("uatom"); !limit.Min.Equal(sdk.NewInt(50_000)) {
+		t.Errorf("announced min donation = %s, want 50000", limit.Min)
+	}
+
+	// A second update would replace the pending change, so it is refused
+	if err := f.k.UpdateDonationLimits(f.ctx, testAdmin.String(), uatom(20_000), uatom(200_000_000)); !errors.Is(err, sdkerrors.ErrInvalidRequest) {
+		t.Fatalf("UpdateDonationLimits while pending: error = %v, want %v", err, sdkerrors.ErrInvalidRequest)
+	}
+
+	f.ctx = f.ctx.WithBlockHeight(pending.EffectiveHeight)
+	if err := f.k.applyPendingParams(f.ctx); err != nil {
+		t.Fatalf("applyPendingParams: %v", err)
+	}
+	if limit, _ := f.k.GetParams(f.ctx).DonationLimits.Get("uatom"); !limit.Min.Equal(sdk.NewInt(50_000)) {
+		t.Errorf("min donation = %s after the delay, want 50000", limit.Min)
+	}
+}
@@ -0,0 +1,327 @@
+package donation
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Query types mirroring the Query service in proto/donation/v1/query.proto
+
+// QueryServer is the server API for the donation Query service
+type QueryServer interface {
+	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	Donors(context.Context, *QueryDonorsRequest) (*QueryDonorsResponse, error)
+	Donor(context.Context, *QueryDonorRequest) (*QueryDonorResponse, error)
+	Leaderboard(context.Context, *QueryLeaderboardRequest) (*QueryLeaderboardResponse, error)
+	DonorStatus(context.Context, *QueryDonorStatusRequest) (*QueryDonorStatusResponse, error)
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
+	StateAt(context.Context, *QueryStateAtRequest) (*QueryStateAtResponse, error)
+	DonorAt(context.Context, *QueryDonorAtRequest) (*QueryDonorAtResponse, error)
+	DonationsByDonor(context.Context, *QueryDonationsByDonorRequest) (*QueryDonationsByDonorResponse, error)
+	DonationsByTimeRange(context.Context, *QueryDonationsByTimeRangeRequest) (*QueryDonationsByTimeRangeResponse, error)
+	TierStats(context.Context, *QueryTierStatsRequest) (*QueryTierStatsResponse, error)
+	DonationStats(context.Context, *QueryDonationStatsRequest) (*QueryDonationStatsResponse, error)
+	DonorsByTier(context.Context, *QueryDonorsByTierRequest) (*QueryDonorsByTierResponse, error)
+	ModuleAccount(context.Context, *QueryModuleAccountRequest) (*QueryModuleAccountResponse, error)
+	Loyalty(context.Context, *QueryLoyaltyRequest) (*QueryLoyaltyResponse, error)
+	TeamLeaderboard(context.Context, *QueryTeamLeaderboardRequest) (*QueryTeamLeaderboardResponse, error)
+	CampaignRanking(context.Context, *QueryCampaignRankingRequest) (*QueryCampaignRankingResponse, error)
+	CampaignUpdates(context.Context, *QueryCampaignUpdatesRequest) (*QueryCampaignUpdatesResponse, error)
+	DonorSetAnchor(context.Context, *QueryDonorSetAnchorRequest) (*QueryDonorSetAnchorResponse, error)
+	DonorSetProof(context.Context, *QueryDonorSetProofRequest) (*QueryDonorSetProofResponse, error)
+	DonorSnapshot(context.Context, *QueryDonorSnapshotRequest) (*QueryDonorSnapshotResponse, error)
+	DonorSnapshotProof(context.Context, *QueryDonorSnapshotProofRequest) (*QueryDonorSnapshotProofResponse, error)
+	PendingParams(context.Context, *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error)
+	PendingAdmin(context.Context, *QueryPendingAdminRequest) (*QueryPendingAdminResponse, error)
+	DisabledMsgs(context.Context, *QueryDisabledMsgsRequest) (*QueryDisabledMsgsResponse, error)
+	RoleMembers(context.Context, *QueryRoleMembersRequest) (*QueryRoleMembersResponse, error)
+	PendingEmergencyWithdrawal(context.Context, *QueryPendingEmergencyWithdrawalRequest) (*QueryPendingEmergencyWithdrawalResponse, error)
+}
+
+// QueryStateRequest is the request type for Query/State
+type QueryStateRequest struct{}
+
+// QueryStateResponse is the response type for Query/State
+type QueryStateResponse struct {
+	State DonationState
+}
+
+// QueryDonorsRequest is the request type for Query/Donors
+type QueryDonorsRequest struct {
+	Pagination *query.PageRequest
+}
+
+// QueryDonorsResponse is the response type for Query/Donors
+type QueryDonorsResponse struct {
+	Donors     []DonorRecord
+	Pagination *query.PageResponse
+}
+
+// QueryDonorRequest is the request type for Query/Donor
+type QueryDonorRequest struct {
+	Address string
+}
+
+// QueryDonorResponse is the response type for Query/Donor
+type QueryDonorResponse struct {
+	Donor DonorRecord
+}
+
+// QueryLeaderboardRequest is the request type for Query/Leaderboard
+type QueryLeaderboardRequest struct {
+	Limit uint32 // 0 for DefaultLeaderboardLimit, at most MaxLeaderboardLimit
+}
+
+// QueryLeaderboardResponse is the response type for Query/Leaderboard
+type QueryLeaderboardResponse struct {
+	Donors []DonorRecord
+}
+
+// QueryDonorStatusRequest is the request type for Query/DonorStatus
+type QueryDonorStatusRequest struct {
+	Address string
+}
+
+// QueryDonorStatusResponse is the response type for Query/DonorStatus
+type QueryDonorStatusResponse struct {
+	Status DonorStatus
+}
+
+// QueryAuditLogRequest is the request type for Query/AuditLog
+type QueryAuditLogRequest struct {
+	Pagination *query.PageRequest
+}
+
+// QueryAuditLogResponse is the response type for Query/AuditLog
+type QueryAuditLogResponse struct {
+	Entries    []AuditEntry
+	Pagination *query.PageResponse
+}
+
+// QueryCampaignRequest is the request type for Query/Campaign
+type QueryCampaignRequest struct {
+	ID uint64
+}
+
+// QueryCampaignResponse is the response type for Query/Campaign
+type QueryCampaignResponse struct {
+	Campaign Campaign
+}
+
+// QueryStateAtRequest is the request type for Query/StateAt
+type QueryStateAtRequest struct {
+	Height int64
+}
+
+// QueryStateAtResponse is the response type for Query/StateAt
+type QueryStateAtResponse struct {
+	State DonationState
+}
+
+// QueryDonorAtRequest is the request type for Query/DonorAt
+type QueryDonorAtRequest struct {
+	Address string
+	Height  int64
+}
+
+// QueryDonorAtResponse is the response type for Query/DonorAt
+type QueryDonorAtResponse struct {
+	Donor DonorRecord
+}
+
+// QueryDonationsByDonorRequest is the request type for Query/DonationsByDonor
+type QueryDonationsByDonorRequest struct {
+	Address    string
+	Pagination *query.PageRequest
+}
+
+// QueryDonationsByDonorResponse is the response type for Query/DonationsByDonor
+type QueryDonationsByDonorResponse struct {
+	Donations  []Donation
+	Pagination *query.PageResponse
+}
+
+// QueryDonationsByTimeRangeRequest is the request type for Query/DonationsByTimeRange
+type QueryDonationsByTimeRangeRequest struct {
+	Start      int64 // unix seconds, inclusive
+	End        int64 // unix seconds, inclusive
+	Pagination *query.PageRequest
+}
+
+// QueryDonationsByTimeRangeResponse is the response type for Query/DonationsByTimeRange
+type QueryDonationsByTimeRangeResponse struct {
+	Donations  []Donation
+	Pagination *query.PageResponse
+}
+
+// QueryTierStatsRequest is the request type for Query/TierStats
+type QueryTierStatsRequest struct{}
+
+// QueryTierStatsResponse is the response type for Query/TierStats
+type QueryTierStatsResponse struct {
+	Stats []TierStats
+}
+
+// QueryDonationStatsRequest is the request type for Query/DonationStats
+type QueryDonationStatsRequest struct {
+	Period StatsPeriod
+	Start  int64
+	End    int64
+}
+
+// QueryDonationStatsResponse is the response type for Query/DonationStats
+type QueryDonationStatsResponse struct {
+	Buckets []DonationBucket
+}
+
+// QueryDonorsByTierRequest is the request type for Query/DonorsByTier
+type QueryDonorsByTierRequest struct {
+	Tier       DonorTier
+	Pagination *query.PageRequest
+}
+
+// QueryDonorsByTierResponse is the response type for Query/DonorsByTier
+type QueryDonorsByTierResponse struct {
+	Donors     []DonorRecord
+	Pagination *query.PageResponse
+}
+
+// QueryModuleAccountRequest is the request type for Query/ModuleAccount
+type QueryModuleAccountRequest struct{}
+
+// QueryModuleAccountResponse is the response type for Query/ModuleAccount
+type QueryModuleAccountResponse struct {
+	Account ModuleAccountInfo
+}
+
+// QueryLoyaltyRequest is the request type for Query/Loyalty
+type QueryLoyaltyRequest struct {
+	Address string
+}
+
+// QueryLoyaltyResponse is the response type for Query/Loyalty
+type QueryLoyaltyResponse struct {
+	Loyalty LoyaltyStatus
+}
+
+// QueryTeamLeaderboardRequest is the request type for Query/TeamLeaderboard
+type QueryTeamLeaderboardRequest struct {
+	Limit uint32 // 0 for DefaultLeaderboardLimit, at most MaxLeaderboardLimit
+}
+
+// QueryTeamLeaderboardResponse is the response type for Query/TeamLeaderboard
+type QueryTeamLeaderboardResponse struct {
+	Teams []Team
+}
+
+// QueryCampaignRankingRequest is the request type for Query/CampaignRanking
+type QueryCampaignRankingRequest struct {
+	Limit uint32 // 0 for DefaultRankingLimit, at most MaxRankingLimit
+}
+
+// QueryCampaignRankingResponse is the response type for Query/CampaignRanking
+type QueryCampaignRankingResponse struct {
+	Ranks []CampaignRank
+}
+
+// QueryCampaignUpdatesRequest is the request type for Query/CampaignUpdates
+type QueryCampaignUpdatesRequest struct {
+	ID         uint64
+	Pagination *query.PageRequest
+}
+
+// QueryCampaignUpdatesResponse is the response type for Query/CampaignUpdates
+type QueryCampaignUpdatesResponse struct {
+	Updates    []CampaignUpdate
+	Pagination *query.PageResponse
+}
+
+// QueryDonorSetAnchorRequest is the request type for Query/DonorSetAnchor
+type QueryDonorSetAnchorRequest struct {
+	Epoch uint64
+}
+
+// QueryDonorSetAnchorResponse is the response type for Query/DonorSetAnchor
+type QueryDonorSetAnchorResponse struct {
+	Anchor DonorSetAnchor
+}
+
+// QueryDonorSetProofRequest is the request type for Query/DonorSetProof
+type QueryDonorSetProofRequest struct {
+	Epoch   uint64
+	Address string
+	Height  int64 // the anchor's height; the query is served at it
+}
+
+// QueryDonorSetProofResponse is the response type for Query/DonorSetProof
+type QueryDonorSetProofResponse struct {
+	Donor DonorRecord
+	Proof []MerkleProofStep
+}
+
+// QueryDonorSnapshotRequest is the request type for Query/DonorSnapshot
+type QueryDonorSnapshotRequest struct {
+	ID uint64
+}
+
+// QueryDonorSnapshotResponse is the response type for Query/DonorSnapshot
+type QueryDonorSnapshotResponse struct {
+	Snapshot DonorSnapshot
+}
+
+// QueryDonorSnapshotProofRequest is the request type for Query/DonorSnapshotProof
+type QueryDonorSnapshotProofRequest struct {
+	ID      uint64
+	Address string
+	Height  int64 // the snapshot's height; the query is served at it
+}
+
+// QueryDonorSnapshotProofResponse is the response type for Query/DonorSnapshotProof
+type QueryDonorSnapshotProofResponse struct {
+	Donor DonorRecord
+	Proof []MerkleProofStep
+}
+
+// QueryPendingParamsRequest is the request type for Query/PendingParams
+type QueryPendingParamsRequest struct{}
+
+// QueryPendingParamsResponse is the response type for Query/PendingParams
+type QueryPendingParamsResponse struct {
+	Pending *PendingParamsChange // nil when no change is announced
+}
+
+// QueryPendingAdminRequest is the request type for Query/PendingAdmin
+type QueryPendingAdminRequest struct{}
+
+// QueryPendingAdminResponse is the response type for Query/PendingAdmin
+type QueryPendingAdminResponse struct {
+	PendingAdmin string // empty when no transfer is pending
+}
+
+// QueryDisabledMsgsRequest is the request type for Query/DisabledMsgs
+type QueryDisabledMsgsRequest struct{}
+
+// QueryDisabledMsgsResponse is the response type for Query/DisabledMsgs
+type QueryDisabledMsgsResponse struct {
+	TypeURLs []string
+}
+
+// QueryRoleMembersRequest is the request type for Query/RoleMembers
+type QueryRoleMembersRequest struct {
+	Role Role
+}
+
+// QueryRoleMembersResponse is the response type for Query/RoleMembers
+type QueryRoleMembersResponse struct {
+	Members []string // explicit grants; the state admin holds every role implicitly
+}
+
+// QueryPendingEmergencyWithdrawalRequest is the request type for Query/PendingEmergencyWithdrawal
+type QueryPendingEmergencyWithdrawalRequest struct{}
+
+// QueryPendingEmergencyWithdrawalResponse is the response type for Query/PendingEmergencyWithdrawal
+type QueryPendingEmergencyWithdrawalResponse struct {
+	Pending *PendingEmergencyWithdrawal // nil when none is scheduled
+}
@@ -0,0 +1,367 @@
+package donation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck // grpc-gateway v1 takes the APIv1 interface
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// REST routes for the Query service, matching the google.api.http rules in
+// proto/donation/v1/query.proto
+
+var (
+	patternQueryState                      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "state"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonors                     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "donors"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonor                      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"donation", "v1", "donors", "address"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorStatus                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"donation", "v1", "donors", "address", "status"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryLeaderboard                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "leaderboard"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryAuditLog                   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "audit_log"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryCampaign                   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"donation", "v1", "campaigns", "id"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryStateAt                    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"donation", "v1", "state_at", "height"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorAt                    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"donation", "v1", "donors", "address", "at", "height"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonationsByDonor           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"donation", "v1", "donors", "address", "donations"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonationsByTimeRange       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "donations"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryTierStats                  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "tier_stats"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonationStats              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "donation_stats"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorsByTier               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"donation", "v1", "tiers", "tier", "donors"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryModuleAccount              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "module_account"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryLoyalty                    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"donation", "v1", "donors", "address", "loyalty"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryTeamLeaderboard            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "team_leaderboard"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryCampaignRanking            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "campaign_ranking"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryCampaignUpdates            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"donation", "v1", "campaigns", "id", "updates"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorSetAnchor             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"donation", "v1", "anchors", "epoch"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorSetProof              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"donation", "v1", "anchors", "epoch", "proofs", "address"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorSnapshot              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"donation", "v1", "snapshots", "id"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDonorSnapshotProof         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"donation", "v1", "snapshots", "id", "proofs", "address"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryPendingParams              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "pending_params"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryPendingAdmin               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "pending_admin"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryDisabledMsgs               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "disabled_msgs"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryRoleMembers                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"donation", "v1", "roles", "role", "members"}, "", runtime.AssumeColonVerbOpt(false)))
+	patternQueryPendingEmergencyWithdrawal = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"donation", "v1", "pending_emergency_withdrawal"}, "", runtime.AssumeColonVerbOpt(false)))
+
+	// Query parameters bound from the path; none are set by query string
+	noPathParams = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+// gatewayCall decodes a REST request, calls the Query service and returns
+// its response
+type gatewayCall func(ctx context.Context, req *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error)
+
+// RegisterQueryHandlerClient registers the Query service's REST routes on
+// mux, forwarding each request to client
+func RegisterQueryHandlerClient(_ context.Context, mux *runtime.ServeMux, client QueryClient) error {
+	callOpts := func(md *runtime.ServerMetadata) []grpc.CallOption {
+		return []grpc.CallOption{grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD)}
+	}
+
+	mux.Handle("GET", patternQueryState, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.State(ctx, &QueryStateRequest{}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonors, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonorsRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.Donors(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonor, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		return client.Donor(ctx, &QueryDonorRequest{Address: address}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorStatus, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		return client.DonorStatus(ctx, &QueryDonorStatusRequest{Address: address}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryLeaderboard, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryLeaderboardRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.Leaderboard(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryAuditLog, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryAuditLogRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.AuditLog(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryCampaign, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		id, err := uint64PathParam(pathParams, "id")
+		if err != nil {
+			return nil, err
+		}
+		return client.Campaign(ctx, &QueryCampaignRequest{ID: id}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryStateAt, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		height, err := int64PathParam(pathParams, "height")
+		if err != nil {
+			return nil, err
+		}
+		return client.StateAt(ctx, &QueryStateAtRequest{Height: height}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorAt, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		height, err := int64PathParam(pathParams, "height")
+		if err != nil {
+			return nil, err
+		}
+		return client.DonorAt(ctx, &QueryDonorAtRequest{Address: address, Height: height}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonationsByDonor, gatewayHandler(mux, func(ctx context.Context, req *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonationsByDonorRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		in.Address = address
+		return client.DonationsByDonor(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonationsByTimeRange, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonationsByTimeRangeRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.DonationsByTimeRange(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryTierStats, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.TierStats(ctx, &QueryTierStatsRequest{}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonationStats, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonationStatsRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.DonationStats(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorsByTier, gatewayHandler(mux, func(ctx context.Context, req *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonorsByTierRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		tier, err := uint64PathParam(pathParams, "tier")
+		if err != nil {
+			return nil, err
+		}
+		if tier > uint64(TierPlatinum) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown tier %d", tier)
+		}
+		in.Tier = DonorTier(tier)
+		return client.DonorsByTier(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryModuleAccount, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.ModuleAccount(ctx, &QueryModuleAccountRequest{}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryLoyalty, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		return client.Loyalty(ctx, &QueryLoyaltyRequest{Address: address}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryTeamLeaderboard, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryTeamLeaderboardRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.TeamLeaderboard(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryCampaignRanking, gatewayHandler(mux, func(ctx context.Context, req *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryCampaignRankingRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		return client.CampaignRanking(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryCampaignUpdates, gatewayHandler(mux, func(ctx context.Context, req *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryCampaignUpdatesRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		id, err := uint64PathParam(pathParams, "id")
+		if err != nil {
+			return nil, err
+		}
+		in.ID = id
+		return client.CampaignUpdates(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorSetAnchor, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		epoch, err := uint64PathParam(pathParams, "epoch")
+		if err != nil {
+			return nil, err
+		}
+		return client.DonorSetAnchor(ctx, &QueryDonorSetAnchorRequest{Epoch: epoch}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorSetProof, gatewayHandler(mux, func(ctx context.Context, req *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonorSetProofRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		epoch, err := uint64PathParam(pathParams, "epoch")
+		if err != nil {
+			return nil, err
+		}
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		in.Epoch, in.Address = epoch, address
+		return client.DonorSetProof(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorSnapshot, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		id, err := uint64PathParam(pathParams, "id")
+		if err != nil {
+			return nil, err
+		}
+		return client.DonorSnapshot(ctx, &QueryDonorSnapshotRequest{ID: id}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDonorSnapshotProof, gatewayHandler(mux, func(ctx context.Context, req *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		var in QueryDonorSnapshotProofRequest
+		if err := populateQuery(req, &in); err != nil {
+			return nil, err
+		}
+		id, err := uint64PathParam(pathParams, "id")
+		if err != nil {
+			return nil, err
+		}
+		address, ok := pathParams["address"]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", "address")
+		}
+		in.ID, in.Address = id, address
+		return client.DonorSnapshotProof(ctx, &in, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryPendingParams, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.PendingParams(ctx, &QueryPendingParamsRequest{}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryPendingAdmin, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.PendingAdmin(ctx, &QueryPendingAdminRequest{}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryDisabledMsgs, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.DisabledMsgs(ctx, &QueryDisabledMsgsRequest{}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryRoleMembers, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, pathParams map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		role, err := uint64PathParam(pathParams, "role")
+		if err != nil {
+			return nil, err
+		}
+		if role > uint64(RoleAttester) || !Role(role).IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown role %d", role)
+		}
+		return client.RoleMembers(ctx, &QueryRoleMembersRequest{Role: Role(role)}, callOpts(md)...)
+	}))
+
+	mux.Handle("GET", patternQueryPendingEmergencyWithdrawal, gatewayHandler(mux, func(ctx context.Context, _ *http.Request, _ map[string]string, md *runtime.ServerMetadata) (proto.Message, error) {
+		return client.PendingEmergencyWithdrawal(ctx, &QueryPendingEmergencyWithdrawalRequest{}, callOpts(md)...)
+	}))
+
+	return nil
+}
+
+// gatewayHandler serves a REST route: it annotates the context with the
+// request's metadata, makes the call and writes the response or error
+func gatewayHandler(mux *runtime.ServeMux, call gatewayCall) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		var md runtime.ServerMetadata
+		resp, err := call(rctx, req, pathParams, &md)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// uint64PathParam parses a numeric path parameter such as a campaign ID
+func uint64PathParam(pathParams map[string]string, name string) (uint64, error) {
+	value, ok := pathParams[name]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "missing parameter %s", name)
+	}
+	id, err := runtime.Uint64(value)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", name, err)
+	}
+	return id, nil
+}
+
+// int64PathParam parses a signed numeric path parameter such as a height
+func int64PathParam(pathParams map[string]string, name string) (int64, error) {
+	value, ok := pathParams[name]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "missing parameter %s", name)
+	}
+	n, err := runtime.Int64(value)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", name, err)
+	}
+	return n, nil
+}
+
+// populateQuery sets a request's fields from the URL query string, e.g.
+// ?pagination.limit=10
+func populateQuery(req *http.Request, in proto.Message) error {
+	if err := req.ParseForm(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := runtime.PopulateQueryParameters(in, req.Form, noPathParams); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
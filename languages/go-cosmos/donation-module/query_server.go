@@ -0,0 +1,367 @@
+package donation
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+var _ QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the donation QueryServer
+func NewQueryServerImpl(keeper Keeper) QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// State returns the module's global state
+func (q queryServer) State(goCtx context.Context, _ *QueryStateRequest) (*QueryStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	state, found := q.Keeper.GetState(ctx)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "not initialized")
+	}
+
+	return &QueryStateResponse{State: state}, nil
+}
+
+// Donors returns a page of donor records, with anonymous donors redacted
+func (q queryServer) Donors(goCtx context.Context, req *QueryDonorsRequest) (*QueryDonorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donors, pageRes, err := q.Keeper.QueryDonors(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonorsResponse{Donors: donors, Pagination: pageRes}, nil
+}
+
+// Donor returns a donor's record with its effective tier; an anonymous
+// donor's address is redacted
+func (q queryServer) Donor(goCtx context.Context, req *QueryDonorRequest) (*QueryDonorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	donor, found := q.Keeper.GetDonor(ctx, req.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor %s not found", req.Address)
+	}
+
+	return &QueryDonorResponse{Donor: q.Keeper.withEffectiveTier(ctx, donor).Public()}, nil
+}
+
+// Leaderboard returns the top donors by score
+func (q queryServer) Leaderboard(goCtx context.Context, req *QueryLeaderboardRequest) (*QueryLeaderboardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &QueryLeaderboardResponse{Donors: q.Keeper.QueryLeaderboard(ctx, req.Limit)}, nil
+}
+
+// DonorStatus returns a donor's tier, totals, rank and the gap to the next
+// tier; an address that has never donated gets a zero status
+func (q queryServer) DonorStatus(goCtx context.Context, req *QueryDonorStatusRequest) (*QueryDonorStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return &QueryDonorStatusResponse{Status: q.Keeper.GetDonorStatus(ctx, req.Address)}, nil
+}
+
+// AuditLog returns a page of the audit log of privileged actions
+func (q queryServer) AuditLog(goCtx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entries, pageRes, err := q.Keeper.QueryAuditLog(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryAuditLogResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// Campaign returns a campaign with its goal and the amount raised
+func (q queryServer) Campaign(goCtx context.Context, req *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := q.Keeper.GetCampaign(ctx, req.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", req.ID)
+	}
+
+	return &QueryCampaignResponse{Campaign: campaign}, nil
+}
+
+// StateAt returns the module's global state as of a past height; the query
+// must be served at that height
+func (q queryServer) StateAt(goCtx context.Context, req *QueryStateAtRequest) (*QueryStateAtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	state, found, err := q.Keeper.GetStateAt(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "not initialized at height %d", req.Height)
+	}
+
+	return &QueryStateAtResponse{State: state}, nil
+}
+
+// DonorAt returns a donor's record as of a past height, with an anonymous
+// donor's address redacted; the query must be served at that height
+func (q queryServer) DonorAt(goCtx context.Context, req *QueryDonorAtRequest) (*QueryDonorAtResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	donor, found, err := q.Keeper.GetDonorAt(ctx, req.Address, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor %s not found at height %d", req.Address, req.Height)
+	}
+
+	return &QueryDonorAtResponse{Donor: q.Keeper.withEffectiveTier(ctx, donor).Public()}, nil
+}
+
+// DonationsByDonor returns a page of a donor's donations, oldest first,
+// with anonymous donations redacted
+func (q queryServer) DonationsByDonor(goCtx context.Context, req *QueryDonationsByDonorRequest) (*QueryDonationsByDonorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	donations, pageRes, err := q.Keeper.QueryDonationsByDonor(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonationsByDonorResponse{Donations: publicDonations(donations), Pagination: pageRes}, nil
+}
+
+// DonationsByTimeRange returns a page of the donations received in a time
+// range, oldest first, with anonymous donations redacted
+func (q queryServer) DonationsByTimeRange(goCtx context.Context, req *QueryDonationsByTimeRangeRequest) (*QueryDonationsByTimeRangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donations, pageRes, err := q.Keeper.QueryDonationsByTimeRange(ctx, req.Start, req.End, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonationsByTimeRangeResponse{Donations: publicDonations(donations), Pagination: pageRes}, nil
+}
+
+// TierStats returns the donor count and lifetime total of every tier
+func (q queryServer) TierStats(goCtx context.Context, _ *QueryTierStatsRequest) (*QueryTierStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &QueryTierStatsResponse{Stats: q.Keeper.QueryTierStats(ctx)}, nil
+}
+
+// DonationStats returns the period's donation buckets in a time range
+func (q queryServer) DonationStats(goCtx context.Context, req *QueryDonationStatsRequest) (*QueryDonationStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buckets, err := q.Keeper.QueryDonationStats(ctx, req.Period, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonationStatsResponse{Buckets: buckets}, nil
+}
+
+// DonorsByTier returns a page of the donors in a tier
+func (q queryServer) DonorsByTier(goCtx context.Context, req *QueryDonorsByTierRequest) (*QueryDonorsByTierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Tier > TierPlatinum {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown tier %d", req.Tier)
+	}
+
+	donors, pageRes, err := q.Keeper.QueryDonorsByTier(ctx, req.Tier, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonorsByTierResponse{Donors: donors, Pagination: pageRes}, nil
+}
+
+// ModuleAccount returns the module account address and its balances
+func (q queryServer) ModuleAccount(goCtx context.Context, _ *QueryModuleAccountRequest) (*QueryModuleAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &QueryModuleAccountResponse{Account: q.Keeper.QueryModuleAccount(ctx)}, nil
+}
+
+// Loyalty returns a donor's loyalty points and streak
+func (q queryServer) Loyalty(goCtx context.Context, req *QueryLoyaltyRequest) (*QueryLoyaltyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	loyalty, found := q.Keeper.QueryLoyalty(ctx, req.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor %s not found", req.Address)
+	}
+
+	return &QueryLoyaltyResponse{Loyalty: loyalty}, nil
+}
+
+// TeamLeaderboard returns the top teams by total
+func (q queryServer) TeamLeaderboard(goCtx context.Context, req *QueryTeamLeaderboardRequest) (*QueryTeamLeaderboardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &QueryTeamLeaderboardResponse{Teams: q.Keeper.QueryTeamLeaderboard(ctx, req.Limit)}, nil
+}
+
+// CampaignRanking ranks the active campaigns by amount raised and boosts
+func (q queryServer) CampaignRanking(goCtx context.Context, req *QueryCampaignRankingRequest) (*QueryCampaignRankingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &QueryCampaignRankingResponse{Ranks: q.Keeper.QueryCampaignRanking(ctx, req.Limit)}, nil
+}
+
+// CampaignUpdates returns a page of a campaign's update feed
+func (q queryServer) CampaignUpdates(goCtx context.Context, req *QueryCampaignUpdatesRequest) (*QueryCampaignUpdatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := q.Keeper.GetCampaign(ctx, req.ID); !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", req.ID)
+	}
+
+	updates, pageRes, err := q.Keeper.QueryCampaignUpdates(ctx, req.ID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryCampaignUpdatesResponse{Updates: updates, Pagination: pageRes}, nil
+}
+
+// DonorSetAnchor returns the donor-set anchor of an epoch
+func (q queryServer) DonorSetAnchor(goCtx context.Context, req *QueryDonorSetAnchorRequest) (*QueryDonorSetAnchorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	anchor, found := q.Keeper.GetDonorSetAnchor(ctx, req.Epoch)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no anchor for epoch %d", req.Epoch)
+	}
+
+	return &QueryDonorSetAnchorResponse{Anchor: anchor}, nil
+}
+
+// DonorSetProof returns a donor's membership proof in an epoch's donor
+// set; the query must be served at the anchor's height
+func (q queryServer) DonorSetProof(goCtx context.Context, req *QueryDonorSetProofRequest) (*QueryDonorSetProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donor, proof, err := q.Keeper.GetDonorSetProof(ctx, req.Epoch, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonorSetProofResponse{Donor: donor, Proof: proof}, nil
+}
+
+// DonorSnapshot returns a donor snapshot
+func (q queryServer) DonorSnapshot(goCtx context.Context, req *QueryDonorSnapshotRequest) (*QueryDonorSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	snapshot, found := q.Keeper.GetDonorSnapshot(ctx, req.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor snapshot %d not found", req.ID)
+	}
+
+	return &QueryDonorSnapshotResponse{Snapshot: snapshot}, nil
+}
+
+// DonorSnapshotProof returns a donor's inclusion proof in a snapshot; the
+// query must be served at the snapshot's height
+func (q queryServer) DonorSnapshotProof(goCtx context.Context, req *QueryDonorSnapshotProofRequest) (*QueryDonorSnapshotProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donor, proof, err := q.Keeper.GetDonorSnapshotProof(ctx, req.ID, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryDonorSnapshotProofResponse{Donor: donor, Proof: proof}, nil
+}
+
+// PendingParams returns the announced params change, if any
+func (q queryServer) PendingParams(goCtx context.Context, _ *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending, found := q.Keeper.GetPendingParamsChange(ctx)
+	if !found {
+		return &QueryPendingParamsResponse{}, nil
+	}
+
+	return &QueryPendingParamsResponse{Pending: &pending}, nil
+}
+
+// PendingAdmin returns the proposed admin awaiting acceptance, if any
+func (q queryServer) PendingAdmin(goCtx context.Context, _ *QueryPendingAdminRequest) (*QueryPendingAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending, _ := q.Keeper.GetPendingAdmin(ctx)
+	return &QueryPendingAdminResponse{PendingAdmin: pending}, nil
+}
+
+// DisabledMsgs returns the type URLs of the Msgs disabled by the circuit
+// breaker
+func (q queryServer) DisabledMsgs(goCtx context.Context, _ *QueryDisabledMsgsRequest) (*QueryDisabledMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &QueryDisabledMsgsResponse{TypeURLs: q.Keeper.QueryDisabledMsgs(ctx)}, nil
+}
+
+// RoleMembers returns the addresses explicitly granted a role
+func (q queryServer) RoleMembers(goCtx context.Context, req *QueryRoleMembersRequest) (*QueryRoleMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !req.Role.IsValid() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown role %d", req.Role)
+	}
+
+	return &QueryRoleMembersResponse{Members: q.Keeper.GetRoleMembers(ctx, req.Role)}, nil
+}
+
+// PendingEmergencyWithdrawal returns the scheduled emergency withdrawal, if
+// any
+func (q queryServer) PendingEmergencyWithdrawal(goCtx context.Context, _ *QueryPendingEmergencyWithdrawalRequest) (*QueryPendingEmergencyWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending, found := q.Keeper.GetPendingEmergencyWithdrawal(ctx)
+	if !found {
+		return &QueryPendingEmergencyWithdrawalResponse{}, nil
+	}
+
+	return &QueryPendingEmergencyWithdrawalResponse{Pending: &pending}, nil
+}
+
+// publicDonations redacts the anonymous donations in donations
+func publicDonations(donations []Donation) []Donation {
+	for i := range donations {
+		donations[i] = donations[i].Public()
+	}
+	return donations
+}
@@ -0,0 +1,341 @@
+package donation
+
+import (
+	"context"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Query service wiring for proto/donation/v1/query.proto, kept by hand next
+// to the hand-written Query types
+
+// RegisterQueryServer registers srv as the donation Query service
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&queryServiceDesc, srv)
+}
+
+var queryServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "State", Handler: queryHandler("State", QueryServer.State)},
+		{MethodName: "Donors", Handler: queryHandler("Donors", QueryServer.Donors)},
+		{MethodName: "Donor", Handler: queryHandler("Donor", QueryServer.Donor)},
+		{MethodName: "Leaderboard", Handler: queryHandler("Leaderboard", QueryServer.Leaderboard)},
+		{MethodName: "DonorStatus", Handler: queryHandler("DonorStatus", QueryServer.DonorStatus)},
+		{MethodName: "AuditLog", Handler: queryHandler("AuditLog", QueryServer.AuditLog)},
+		{MethodName: "Campaign", Handler: queryHandler("Campaign", QueryServer.Campaign)},
+		{MethodName: "StateAt", Handler: queryHandler("StateAt", QueryServer.StateAt)},
+		{MethodName: "DonorAt", Handler: queryHandler("DonorAt", QueryServer.DonorAt)},
+		{MethodName: "DonationsByDonor", Handler: queryHandler("DonationsByDonor", QueryServer.DonationsByDonor)},
+		{MethodName: "DonationsByTimeRange", Handler: queryHandler("DonationsByTimeRange", QueryServer.DonationsByTimeRange)},
+		{MethodName: "TierStats", Handler: queryHandler("TierStats", QueryServer.TierStats)},
+		{MethodName: "DonationStats", Handler: queryHandler("DonationStats", QueryServer.DonationStats)},
+		{MethodName: "DonorsByTier", Handler: queryHandler("DonorsByTier", QueryServer.DonorsByTier)},
+		{MethodName: "ModuleAccount", Handler: queryHandler("ModuleAccount", QueryServer.ModuleAccount)},
+		{MethodName: "Loyalty", Handler: queryHandler("Loyalty", QueryServer.Loyalty)},
+		{MethodName: "TeamLeaderboard", Handler: queryHandler("TeamLeaderboard", QueryServer.TeamLeaderboard)},
+		{MethodName: "CampaignRanking", Handler: queryHandler("CampaignRanking", QueryServer.CampaignRanking)},
+		{MethodName: "CampaignUpdates", Handler: queryHandler("CampaignUpdates", QueryServer.CampaignUpdates)},
+		{MethodName: "DonorSetAnchor", Handler: queryHandler("DonorSetAnchor", QueryServer.DonorSetAnchor)},
+		{MethodName: "DonorSetProof", Handler: queryHandler("DonorSetProof", QueryServer.DonorSetProof)},
+		{MethodName: "DonorSnapshot", Handler: queryHandler("DonorSnapshot", QueryServer.DonorSnapshot)},
+		{MethodName: "DonorSnapshotProof", Handler: queryHandler("DonorSnapshotProof", QueryServer.DonorSnapshotProof)},
+		{MethodName: "PendingParams", Handler: queryHandler("PendingParams", QueryServer.PendingParams)},
+		{MethodName: "PendingAdmin", Handler: queryHandler("PendingAdmin", QueryServer.PendingAdmin)},
+		{MethodName: "DisabledMsgs", Handler: queryHandler("DisabledMsgs", QueryServer.DisabledMsgs)},
+		{MethodName: "RoleMembers", Handler: queryHandler("RoleMembers", QueryServer.RoleMembers)},
+		{MethodName: "PendingEmergencyWithdrawal", Handler: queryHandler("PendingEmergencyWithdrawal", QueryServer.PendingEmergencyWithdrawal)},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "donation/v1/query.proto",
+}
+
+// queryHandler adapts a QueryServer method to a gRPC unary handler
+func queryHandler[Req, Res any](
+	method string,
+	serve func(QueryServer, context.Context, *Req) (*Res, error),
+) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	fullMethod := "/donation.v1.Query/" + method
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := new(Req)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return serve(srv.(QueryServer), ctx, in)
+		}
+
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return serve(srv.(QueryServer), ctx, req.(*Req))
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+// QueryClient is the client API for the donation Query service
+type QueryClient interface {
+	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	Donors(ctx context.Context, in *QueryDonorsRequest, opts ...grpc.CallOption) (*QueryDonorsResponse, error)
+	Donor(ctx context.Context, in *QueryDonorRequest, opts ...grpc.CallOption) (*QueryDonorResponse, error)
+	Leaderboard(ctx context.Context, in *QueryLeaderboardRequest, opts ...grpc.CallOption) (*QueryLeaderboardResponse, error)
+	DonorStatus(ctx context.Context, in *QueryDonorStatusRequest, opts ...grpc.CallOption) (*QueryDonorStatusResponse, error)
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
+	StateAt(ctx context.Context, in *QueryStateAtRequest, opts ...grpc.CallOption) (*QueryStateAtResponse, error)
+	DonorAt(ctx context.Context, in *QueryDonorAtRequest, opts ...grpc.CallOption) (*QueryDonorAtResponse, error)
+	DonationsByDonor(ctx context.Context, in *QueryDonationsByDonorRequest, opts ...grpc.CallOption) (*QueryDonationsByDonorResponse, error)
+	DonationsByTimeRange(ctx context.Context, in *QueryDonationsByTimeRangeRequest, opts ...grpc.CallOption) (*QueryDonationsByTimeRangeResponse, error)
+	TierStats(ctx context.Context, in *QueryTierStatsRequest, opts ...grpc.CallOption) (*QueryTierStatsResponse, error)
+	DonationStats(ctx context.Context, in *QueryDonationStatsRequest, opts ...grpc.CallOption) (*QueryDonationStatsResponse, error)
+	DonorsByTier(ctx context.Context, in *QueryDonorsByTierRequest, opts ...grpc.CallOption) (*QueryDonorsByTierResponse, error)
+	ModuleAccount(ctx context.Context, in *QueryModuleAccountRequest, opts ...grpc.CallOption) (*QueryModuleAccountResponse, error)
+	Loyalty(ctx context.Context, in *QueryLoyaltyRequest, opts ...grpc.CallOption) (*QueryLoyaltyResponse, error)
+	TeamLeaderboard(ctx context.Context, in *QueryTeamLeaderboardRequest, opts ...grpc.CallOption) (*QueryTeamLeaderboardResponse, error)
+	CampaignRanking(ctx context.Context, in *QueryCampaignRankingRequest, opts ...grpc.CallOption) (*QueryCampaignRankingResponse, error)
+	CampaignUpdates(ctx context.Context, in *QueryCampaignUpdatesRequest, opts ...grpc.CallOption) (*QueryCampaignUpdatesResponse, error)
+	DonorSetAnchor(ctx context.Context, in *QueryDonorSetAnchorRequest, opts ...grpc.CallOption) (*QueryDonorSetAnchorResponse, error)
+	DonorSetProof(ctx context.Context, in *QueryDonorSetProofRequest, opts ...grpc.CallOption) (*QueryDonorSetProofResponse, error)
+	DonorSnapshot(ctx context.Context, in *QueryDonorSnapshotRequest, opts ...grpc.CallOption) (*QueryDonorSnapshotResponse, error)
+	DonorSnapshotProof(ctx context.Context, in *QueryDonorSnapshotProofRequest, opts ...grpc.CallOption) (*QueryDonorSnapshotProofResponse, error)
+	PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error)
+	PendingAdmin(ctx context.Context, in *QueryPendingAdminRequest, opts ...grpc.CallOption) (*QueryPendingAdminResponse, error)
+	DisabledMsgs(ctx context.Context, in *QueryDisabledMsgsRequest, opts ...grpc.CallOption) (*QueryDisabledMsgsResponse, error)
+	RoleMembers(ctx context.Context, in *QueryRoleMembersRequest, opts ...grpc.CallOption) (*QueryRoleMembersResponse, error)
+	PendingEmergencyWithdrawal(ctx context.Context, in *QueryPendingEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*QueryPendingEmergencyWithdrawalResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+// NewQueryClient returns a QueryClient over cc, e.g. a gRPC connection or
+// a client.Context
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc: cc}
+}
+
+func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
+	out := new(QueryStateResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/State", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Donors(ctx context.Context, in *QueryDonorsRequest, opts ...grpc.CallOption) (*QueryDonorsResponse, error) {
+	out := new(QueryDonorsResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/Donors", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Donor(ctx context.Context, in *QueryDonorRequest, opts ...grpc.CallOption) (*QueryDonorResponse, error) {
+	out := new(QueryDonorResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/Donor", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Leaderboard(ctx context.Context, in *QueryLeaderboardRequest, opts ...grpc.CallOption) (*QueryLeaderboardResponse, error) {
+	out := new(QueryLeaderboardResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/Leaderboard", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DonorStatus(ctx context.Context, in *QueryDonorStatusRequest, opts ...grpc.CallOption) (*QueryDonorStatusResponse, error) {
+	out := new(QueryDonorStatusResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonorStatus", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/AuditLog", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/Campaign", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateAt sends the query at in.Height, so the node serves it from that
+// store version
+func (c *queryClient) StateAt(ctx context.Context, in *QueryStateAtRequest, opts ...grpc.CallOption) (*QueryStateAtResponse, error) {
+	out := new(QueryStateAtResponse)
+	if err := c.cc.Invoke(withQueryHeight(ctx, in.Height), "/donation.v1.Query/StateAt", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DonorAt sends the query at in.Height, like StateAt
+func (c *queryClient) DonorAt(ctx context.Context, in *QueryDonorAtRequest, opts ...grpc.CallOption) (*QueryDonorAtResponse, error) {
+	out := new(QueryDonorAtResponse)
+	if err := c.cc.Invoke(withQueryHeight(ctx, in.Height), "/donation.v1.Query/DonorAt", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DonationsByDonor(ctx context.Context, in *QueryDonationsByDonorRequest, opts ...grpc.CallOption) (*QueryDonationsByDonorResponse, error) {
+	out := new(QueryDonationsByDonorResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonationsByDonor", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) DonationsByTimeRange(ctx context.Context, in *QueryDonationsByTimeRangeRequest, opts ...grpc.CallOption) (*QueryDonationsByTimeRangeResponse, error) {
+	out := new(QueryDonationsByTimeRangeResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonationsByTimeRange", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) TierStats(ctx context.Context, in *QueryTierStatsRequest, opts ...grpc.CallOption) (*QueryTierStatsResponse, error) {
+	out := new(QueryTierStatsResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/TierStats", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) DonationStats(ctx context.Context, in *QueryDonationStatsRequest, opts ...grpc.CallOption) (*QueryDonationStatsResponse, error) {
+	out := new(QueryDonationStatsResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonationStats", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) DonorsByTier(ctx context.Context, in *QueryDonorsByTierRequest, opts ...grpc.CallOption) (*QueryDonorsByTierResponse, error) {
+	out := new(QueryDonorsByTierResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonorsByTier", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) ModuleAccount(ctx context.Context, in *QueryModuleAccountRequest, opts ...grpc.CallOption) (*QueryModuleAccountResponse, error) {
+	out := new(QueryModuleAccountResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/ModuleAccount", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) Loyalty(ctx context.Context, in *QueryLoyaltyRequest, opts ...grpc.CallOption) (*QueryLoyaltyResponse, error) {
+	out := new(QueryLoyaltyResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/Loyalty", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) TeamLeaderboard(ctx context.Context, in *QueryTeamLeaderboardRequest, opts ...grpc.CallOption) (*QueryTeamLeaderboardResponse, error) {
+	out := new(QueryTeamLeaderboardResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/TeamLeaderboard", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) CampaignRanking(ctx context.Context, in *QueryCampaignRankingRequest, opts ...grpc.CallOption) (*QueryCampaignRankingResponse, error) {
+	out := new(QueryCampaignRankingResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/CampaignRanking", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) CampaignUpdates(ctx context.Context, in *QueryCampaignUpdatesRequest, opts ...grpc.CallOption) (*QueryCampaignUpdatesResponse, error) {
+	out := new(QueryCampaignUpdatesResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/CampaignUpdates", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) DonorSetAnchor(ctx context.Context, in *QueryDonorSetAnchorRequest, opts ...grpc.CallOption) (*QueryDonorSetAnchorResponse, error) {
+	out := new(QueryDonorSetAnchorResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonorSetAnchor", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DonorSetProof sends the query at in.Height, like StateAt
+func (c *queryClient) DonorSetProof(ctx context.Context, in *QueryDonorSetProofRequest, opts ...grpc.CallOption) (*QueryDonorSetProofResponse, error) {
+	out := new(QueryDonorSetProofResponse)
+	if err := c.cc.Invoke(withQueryHeight(ctx, in.Height), "/donation.v1.Query/DonorSetProof", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) DonorSnapshot(ctx context.Context, in *QueryDonorSnapshotRequest, opts ...grpc.CallOption) (*QueryDonorSnapshotResponse, error) {
+	out := new(QueryDonorSnapshotResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DonorSnapshot", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DonorSnapshotProof sends the query at in.Height, like StateAt
+func (c *queryClient) DonorSnapshotProof(ctx context.Context, in *QueryDonorSnapshotProofRequest, opts ...grpc.CallOption) (*QueryDonorSnapshotProofResponse, error) {
+	out := new(QueryDonorSnapshotProofResponse)
+	if err := c.cc.Invoke(withQueryHeight(ctx, in.Height), "/donation.v1.Query/DonorSnapshotProof", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error) {
+	out := new(QueryPendingParamsResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/PendingParams", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) PendingAdmin(ctx context.Context, in *QueryPendingAdminRequest, opts ...grpc.CallOption) (*QueryPendingAdminResponse, error) {
+	out := new(QueryPendingAdminResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/PendingAdmin", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) DisabledMsgs(ctx context.Context, in *QueryDisabledMsgsRequest, opts ...grpc.CallOption) (*QueryDisabledMsgsResponse, error) {
+	out := new(QueryDisabledMsgsResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/DisabledMsgs", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) RoleMembers(ctx context.Context, in *QueryRoleMembersRequest, opts ...grpc.CallOption) (*QueryRoleMembersResponse, error) {
+	out := new(QueryRoleMembersResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/RoleMembers", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+func (c *queryClient) PendingEmergencyWithdrawal(ctx context.Context, in *QueryPendingEmergencyWithdrawalRequest, opts ...grpc.CallOption) (*QueryPendingEmergencyWithdrawalResponse, error) {
+	out := new(QueryPendingEmergencyWithdrawalResponse)
+	if err := c.cc.Invoke(ctx, "/donation.v1.Query/PendingEmergencyWithdrawal", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// withQueryHeight sets the x-cosmos-block-height header that makes baseapp
+// (and client.Context) serve a query from the store version at height
+func withQueryHeight(ctx context.Context, height int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
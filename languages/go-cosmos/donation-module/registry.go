@@ -0,0 +1,261 @@
+package donation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Registry field bounds
+const (
+	MaxOrganizationNameLength = 140
+	MaxMetadataURILength      = 512
+)
+
+// VerificationStatus is the verification state of a registered organization
+type VerificationStatus uint8
+
+const (
+	StatusPending  VerificationStatus = 1 // registered, not yet verified
+	StatusVerified VerificationStatus = 2 // may receive withdrawals in strict mode
+	StatusRevoked  VerificationStatus = 3 // verification withdrawn
+)
+
+// String returns the status name
+func (s VerificationStatus) String() string {
+	switch s {
+	case StatusPending:
+		return "PENDING"
+	case StatusVerified:
+		return "VERIFIED"
+	case StatusRevoked:
+		return "REVOKED"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", uint8(s))
+	}
+}
+
+// IsValid reports whether s is a known status
+func (s VerificationStatus) IsValid() bool {
+	return s >= StatusPending && s <= StatusRevoked
+}
+
+// VerificationStatusFromString parses a status name, case-insensitively
+func VerificationStatusFromString(s string) (VerificationStatus, error) {
+	switch strings.ToUpper(s) {
+	case "PENDING":
+		return StatusPending, nil
+	case "VERIFIED":
+		return StatusVerified, nil
+	case "REVOKED":
+		return StatusRevoked, nil
+	default:
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown verification status %q", s)
+	}
+}
+
+// Organization is a beneficiary organization in the registry
+type Organization struct {
+	Address       string
+	Name          string
+	MetadataURI   string
+	Status        VerificationStatus
+	UpdatedHeight int64
+}
+
+// Validate performs basic validation of an organization entry
+func (o Organization) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return fmt.Errorf("invalid organization address: %w", err)
+	}
+	if o.Name == "" || len(o.Name) > MaxOrganizationNameLength {
+		return fmt.Errorf("organization name must be 1 to %d bytes", MaxOrganizationNameLength)
+	}
+	if len(o.MetadataURI) > MaxMetadataURILength {
+		return fmt.Errorf("metadata URI longer than %d bytes", MaxMetadataURILength)
+	}
+	if !o.Status.IsValid() {
+		return fmt.Errorf("invalid verification status %d", o.Status)
+	}
+	return nil
+}
+
+// GetOrganizationKey returns the store key of a registry entry
+func GetOrganizationKey(addr string) []byte {
+	return append(OrganizationKeyPrefix, address.MustLengthPrefix([]byte(addr))...)
+}
+
+// GetOrganization returns the registry entry for addr
+func (k Keeper) GetOrganization(ctx sdk.Context, addr string) (Organization, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetOrganizationKey(addr))
+	if bz == nil {
+		return Organization{}, false
+	}
+
+	var org Organization
+	k.cdc.MustUnmarshal(bz, &org)
+	return org, true
+}
+
+// SetOrganization stores a registry entry
+func (k Keeper) SetOrganization(ctx sdk.Context, org Organization) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetOrganizationKey(org.Address), k.cdc.MustMarshal(&org))
+}
+
+// IsVerifiedOrganization reports whether addr is a verified organization
+func (k Keeper) IsVerifiedOrganization(ctx sdk.Context, addr string) bool {
+	org, found := k.GetOrganization(ctx, addr)
+	return found && org.Status == StatusVerified
+}
+
+// RegisterOrganization adds an organization as PENDING, or updates the
+// name and metadata URI of a registered one without changing its status.
+// Governance or ADMIN only.
+func (k Keeper) RegisterOrganization(ctx sdk.Context, sender, addr, name, metadataURI string) error {
+	if err := k.checkRegistryUpdate(ctx, sender); err != nil {
+		return err
+	}
+
+	org, found := k.GetOrganization(ctx, addr)
+	if !found {
+		org = Organization{Address: addr, Status: StatusPending}
+	}
+	org.Name = name
+	org.MetadataURI = metadataURI
+	org.UpdatedHeight = ctx.BlockHeight()
+
+	if err := org.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetOrganization(ctx, org)
+
+	if err := k.recordAudit(ctx, sender, AuditRegisterOrganization, map[string]interface{}{
+		"address":      addr,
+		"name":         name,
+		"metadata_uri": metadataURI,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventOrganizationRegistered{
+		Sender:      sender,
+		Address:     addr,
+		Name:        name,
+		MetadataURI: metadataURI,
+		Status:      org.Status.String(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetOrganizationStatus verifies or revokes a registered organization.
+// Governance or ADMIN only.
+func (k Keeper) SetOrganizationStatus(ctx sdk.Context, sender, addr string, status VerificationStatus) error {
+	if err := k.checkRegistryUpdate(ctx, sender); err != nil {
+		return err
+	}
+
+	if !status.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid verification status %d", status)
+	}
+
+	org, found := k.GetOrganization(ctx, addr)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "organization %s not registered", addr)
+	}
+
+	previous := org.Status
+	org.Status = status
+	org.UpdatedHeight = ctx.BlockHeight()
+	k.SetOrganization(ctx, org)
+
+	if err := k.recordAudit(ctx, sender, AuditOrganizationStatus, map[string]interface{}{
+		"address": addr,
+		"status":  status.String(),
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventOrganizationStatusChanged{
+		Sender:         sender,
+		Address:        addr,
+		PreviousStatus: previous.String(),
+		Status:         status.String(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAllOrganizations returns every registry entry
+func (k Keeper) GetAllOrganizations(ctx sdk.Context) []Organization {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), OrganizationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	orgs := []Organization{}
+	for ; iterator.Valid(); iterator.Next() {
+		var org Organization
+		k.cdc.MustUnmarshal(iterator.Value(), &org)
+		orgs = append(orgs, org)
+	}
+
+	return orgs
+}
+
+// QueryOrganizations returns a page of registry entries
+func (k Keeper) QueryOrganizations(
+	ctx sdk.Context,
+	pageReq *query.PageRequest,
+) ([]Organization, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), OrganizationKeyPrefix)
+
+	orgs := []Organization{}
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var org Organization
+		k.cdc.MustUnmarshal(value, &org)
+		orgs = append(orgs, org)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orgs, pageRes, nil
+}
+
+// checkStrictRecipient rejects payouts to addresses that are not verified
+// organizations while Params.StrictBeneficiaries is on
+func (k Keeper) checkStrictRecipient(ctx sdk.Context, recipient string) error {
+	if !k.GetParams(ctx).StrictBeneficiaries {
+		return nil
+	}
+	if !k.IsVerifiedOrganization(ctx, recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a verified organization", recipient)
+	}
+	return nil
+}
+
+func (k Keeper) checkRegistryUpdate(ctx sdk.Context, sender string) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if sender != k.authority && !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance or ADMIN can manage the registry")
+	}
+
+	return nil
+}
@@ -0,0 +1,414 @@
+package donation
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// RemoteDonationTimeout is how long each packet of a forwarded donation may
+// take to be relayed before it times out
+const RemoteDonationTimeout = 10 * time.Minute
+
+// ForwardStatus is the stage a forwarded donation has reached
+type ForwardStatus string
+
+const (
+	// ForwardTransferring: the ICS-20 transfer to the module's interchain
+	// account is in flight
+	ForwardTransferring ForwardStatus = "transferring"
+	// ForwardTransferFailed: the transfer failed and was refunded; a retry
+	// sends it again
+	ForwardTransferFailed ForwardStatus = "transfer_failed"
+	// ForwardDonating: the interchain account tx paying the remote
+	// recipient is in flight
+	ForwardDonating ForwardStatus = "donating"
+	// ForwardDonateFailed: the funds are in the interchain account but the
+	// tx failed; a retry sends the tx again
+	ForwardDonateFailed ForwardStatus = "donate_failed"
+	// ForwardCompleted: the remote chain executed the donation
+	ForwardCompleted ForwardStatus = "completed"
+)
+
+// ForwardedDonation forwards collected funds to another chain through the
+// module's interchain account on that chain's connection
+type ForwardedDonation struct {
+	ID              uint64
+	Sender          string
+	ConnectionID    string // connection to the remote chain
+	TransferChannel string // ICS-20 channel to the remote chain
+	Amount          sdk.Coin
+	RemoteAmount    sdk.Coin // Amount as denominated on the remote chain, set once transferred
+
+	// Recipient is an address on the remote chain; empty donates to the
+	// remote chain's donation module with Memo
+	Recipient string
+	Memo      string
+
+	Status   ForwardStatus
+	Attempts uint32
+	Error    string // reason for the last failure
+
+	// PacketChannel and PacketSequence identify the packet in flight
+	PacketChannel  string
+	PacketSequence uint64
+}
+
+// RegisterRemoteDonationAccount opens the module's interchain account on a
+// connection, or reopens its channel after a timeout closed it. Governance
+// or ADMIN only.
+func (k Keeper) RegisterRemoteDonationAccount(ctx sdk.Context, sender string, connectionID string) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if sender != k.authority && !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance or ADMIN can register interchain accounts")
+	}
+
+	if k.icaControllerKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "interchain accounts are not enabled")
+	}
+
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, k.moduleAddress().String(), ""); err != nil {
+		return err
+	}
+
+	portID, err := k.remoteDonationPortID()
+	if err != nil {
+		return err
+	}
+
+	if err := k.recordAudit(ctx, sender, AuditRegisterRemoteAccount, map[string]interface{}{
+		"connection_id": connectionID,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventRemoteDonationAccountRegistered{
+		Sender:       sender,
+		ConnectionID: connectionID,
+		PortID:       portID,
+	})
+}
+
+// GetRemoteDonationAccount returns the module's interchain account address
+// on a connection, once the channel handshake has completed
+func (k Keeper) GetRemoteDonationAccount(ctx sdk.Context, connectionID string) (string, bool) {
+	if k.icaControllerKeeper == nil {
+		return "", false
+	}
+	portID, err := k.remoteDonationPortID()
+	if err != nil {
+		return "", false
+	}
+	return k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+}
+
+// ForwardDonation sends withdrawable funds to a recipient on another chain:
+// an ICS-20 transfer moves them to the module's interchain account there,
+// and once it is acknowledged the interchain account pays the recipient, or
+// donates to the remote donation module if recipient is empty. The amount
+// counts as withdrawn, and needs the same approval as a withdrawal.
+func (k Keeper) ForwardDonation(
+	ctx sdk.Context,
+	sender string,
+	connectionID string,
+	transferChannel string,
+	amount sdk.Coin,
+	recipient string,
+	memo string,
+) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid forward amount")
+	}
+
+	if _, err := k.checkWithdrawalApproval(ctx, sender, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	if recipient == "" {
+		if err := k.GetParams(ctx).ValidateMemo(memo); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, found := k.GetRemoteDonationAccount(ctx, connectionID); !found {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no interchain account on %s; register one first", connectionID)
+	}
+
+	seq, err := k.forwardSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	forward := ForwardedDonation{
+		ID:              seq + 1,
+		Sender:          sender,
+		ConnectionID:    connectionID,
+		TransferChannel: transferChannel,
+		Amount:          amount,
+		Recipient:       recipient,
+		Memo:            memo,
+	}
+	if err := k.sendForwardTransfer(ctx, &forward); err != nil {
+		return 0, err
+	}
+	k.trackWithdrawal(ctx, sdk.NewCoins(amount))
+
+	return forward.ID, nil
+}
+
+// RetryForwardDonation resumes a failed forward from the stage that failed.
+// Interchain account channels are ordered, so a timed-out tx closes the
+// channel and RegisterRemoteDonationAccount must reopen it first. Needs the
+// same approval as the original forward.
+func (k Keeper) RetryForwardDonation(ctx sdk.Context, sender string, id uint64) error {
+	forward, found := k.GetForwardedDonation(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "forwarded donation %d not found", id)
+	}
+
+	if _, err := k.checkWithdrawalApproval(ctx, sender, sdk.NewCoins(forward.Amount)); err != nil {
+		return err
+	}
+
+	switch forward.Status {
+	case ForwardTransferFailed:
+		return k.sendForwardTransfer(ctx, &forward)
+	case ForwardDonateFailed:
+		if err := k.sendForwardTx(ctx, &forward); err != nil {
+			return err
+		}
+		k.emitForwardStatus(ctx, forward)
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "forwarded donation %d is %s", id, forward.Status)
+	}
+}
+
+// sendForwardTransfer moves a forward's amount to the interchain account
+// and counts it as withdrawn
+func (k Keeper) sendForwardTransfer(ctx sdk.Context, forward *ForwardedDonation) error {
+	state, _ := k.GetState(ctx)
+	if !state.Withdrawable().IsAllGTE(sdk.NewCoins(forward.Amount)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "forward exceeds withdrawable balance")
+	}
+
+	account, found := k.GetRemoteDonationAccount(ctx, forward.ConnectionID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no interchain account on %s", forward.ConnectionID)
+	}
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    forward.TransferChannel,
+		Token:            forward.Amount,
+		Sender:           k.moduleAddress().String(),
+		Receiver:         account,
+		TimeoutTimestamp: uint64(ctx.BlockTime().Add(RemoteDonationTimeout).UnixNano()),
+	})
+	if err != nil {
+		return err
+	}
+
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(forward.Amount)
+	k.SetState(ctx, state)
+
+	forward.Status = ForwardTransferring
+	forward.Attempts++
+	forward.Error = ""
+	k.trackForwardPacket(ctx, forward, forward.TransferChannel, res.Sequence)
+
+	return ctx.EventManager().EmitTypedEvent(&EventDonationForwarded{
+		ID:           forward.ID,
+		Sender:       forward.Sender,
+		ConnectionID: forward.ConnectionID,
+		Amount:       forward.Amount,
+		Recipient:    forward.Recipient,
+		Attempt:      forward.Attempts,
+	})
+}
+
+// sendForwardTx has the interchain account pay the forward's recipient
+func (k Keeper) sendForwardTx(ctx sdk.Context, forward *ForwardedDonation) error {
+	account, found := k.GetRemoteDonationAccount(ctx, forward.ConnectionID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no interchain account on %s", forward.ConnectionID)
+	}
+	portID, err := k.remoteDonationPortID()
+	if err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoins(forward.RemoteAmount)
+	var msg proto.Message
+	if forward.Recipient == "" {
+		msg = &MsgDonate{Donor: account, Amount: amount, Memo: forward.Memo}
+	} else {
+		msg = &banktypes.MsgSend{FromAddress: account, ToAddress: forward.Recipient, Amount: amount}
+	}
+
+	data, err := icatypes.SerializeCosmosTx(k.cdc, []proto.Message{msg})
+	if err != nil {
+		return err
+	}
+
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, nil, forward.ConnectionID, portID, icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}, uint64(ctx.BlockTime().Add(RemoteDonationTimeout).UnixNano()))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "interchain account channel on %s is not open; register the account again", forward.ConnectionID)
+	}
+
+	forward.Status = ForwardDonating
+	forward.Attempts++
+	forward.Error = ""
+	channelID, _ := k.icaControllerKeeper.GetActiveChannelID(ctx, forward.ConnectionID, portID)
+	k.trackForwardPacket(ctx, forward, channelID, sequence)
+	return nil
+}
+
+// onForwardTransferPacket records the outcome of a forward's ICS-20
+// transfer: on success the interchain account tx is sent, on failure the
+// transfer module has refunded the module account and the amount is no
+// longer withdrawn. Errors are recorded on the forward rather than
+// returned, so the packet callback never fails.
+func (k Keeper) onForwardTransferPacket(ctx sdk.Context, packet channeltypes.Packet, ackErr string) {
+	forward, found := k.forwardForPacket(ctx, packet)
+	if !found {
+		return
+	}
+
+	if ackErr != "" {
+		state, _ := k.GetState(ctx)
+		state.TotalWithdrawn = state.TotalWithdrawn.Sub(forward.Amount)
+		k.SetState(ctx, state)
+
+		k.failForward(ctx, forward, ForwardTransferFailed, ackErr)
+		return
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		panic(err)
+	}
+	forward.RemoteAmount = sdk.NewCoin(receivedDenom(packet, data.Denom), forward.Amount.Amount)
+
+	// The tx is sent in a cached context so a failure leaves nothing behind
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.sendForwardTx(cacheCtx, &forward); err != nil {
+		k.failForward(ctx, forward, ForwardDonateFailed, err.Error())
+		return
+	}
+	write()
+	k.emitForwardStatus(ctx, forward)
+}
+
+// onForwardTxPacket records the outcome of a forward's interchain account
+// tx. A failure leaves the funds in the interchain account for a retry.
+func (k Keeper) onForwardTxPacket(ctx sdk.Context, packet channeltypes.Packet, ackErr string) {
+	forward, found := k.forwardForPacket(ctx, packet)
+	if !found {
+		return
+	}
+
+	if ackErr != "" {
+		k.failForward(ctx, forward, ForwardDonateFailed, ackErr)
+		return
+	}
+
+	forward.Status = ForwardCompleted
+	k.setForwardedDonation(ctx, forward)
+	k.emitForwardStatus(ctx, forward)
+}
+
+// failForward marks a forward as failed at a stage
+func (k Keeper) failForward(ctx sdk.Context, forward ForwardedDonation, status ForwardStatus, reason string) {
+	forward.Status = status
+	forward.Error = reason
+	k.setForwardedDonation(ctx, forward)
+	k.emitForwardStatus(ctx, forward)
+}
+
+func (k Keeper) emitForwardStatus(ctx sdk.Context, forward ForwardedDonation) {
+	if err := ctx.EventManager().EmitTypedEvent(&EventForwardStatusChanged{
+		ID:     forward.ID,
+		Status: string(forward.Status),
+		Error:  forward.Error,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// trackForwardPacket stores a forward with the packet now in flight
+func (k Keeper) trackForwardPacket(ctx sdk.Context, forward *ForwardedDonation, channelID string, sequence uint64) {
+	forward.PacketChannel = channelID
+	forward.PacketSequence = sequence
+	k.setForwardedDonation(ctx, *forward)
+	if err := k.forwardPackets.Set(ctx, collections.Join(channelID, sequence), forward.ID); err != nil {
+		panic(err)
+	}
+}
+
+// forwardForPacket returns the forward a packet belongs to and stops
+// tracking the packet
+func (k Keeper) forwardForPacket(ctx sdk.Context, packet channeltypes.Packet) (ForwardedDonation, bool) {
+	key := collections.Join(packet.GetSourceChannel(), packet.GetSequence())
+	id, err := k.forwardPackets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ForwardedDonation{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	if err := k.forwardPackets.Remove(ctx, key); err != nil {
+		panic(err)
+	}
+
+	forward, found := k.GetForwardedDonation(ctx, id)
+	if !found {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrNotFound, "forwarded donation %d", id))
+	}
+	forward.PacketChannel = ""
+	forward.PacketSequence = 0
+	return forward, true
+}
+
+// GetForwardedDonation returns a forwarded donation by ID
+func (k Keeper) GetForwardedDonation(ctx sdk.Context, id uint64) (ForwardedDonation, bool) {
+	forward, err := k.forwards.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ForwardedDonation{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return forward, true
+}
+
+func (k Keeper) setForwardedDonation(ctx sdk.Context, forward ForwardedDonation) {
+	if err := k.forwards.Set(ctx, forward.ID, forward); err != nil {
+		panic(err)
+	}
+}
+
+// remoteDonationPortID returns the ICA controller port owned by the module
+func (k Keeper) remoteDonationPortID() (string, error) {
+	return icatypes.NewControllerPortID(k.moduleAddress().String())
+}
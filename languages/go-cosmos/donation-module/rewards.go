@@ -0,0 +1,378 @@
+package donation
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RewardPool holds the funds for donor rewards: Balance is available for
+// distributions, Allocated is owed to donors and awaiting claims
+type RewardPool struct {
+	Balance   sdk.Coins
+	Allocated sdk.Coins
+}
+
+// RewardTierMultipliers scale each donor's reward weight by their tier.
+// Unset multipliers count as 1; a zero multiplier excludes the tier.
+type RewardTierMultipliers struct {
+	Bronze   sdk.Dec
+	Silver   sdk.Dec
+	Gold     sdk.Dec
+	Platinum sdk.Dec
+}
+
+// Validate rejects negative multipliers
+func (m RewardTierMultipliers) Validate() error {
+	for _, multiplier := range []sdk.Dec{m.Bronze, m.Silver, m.Gold, m.Platinum} {
+		if !multiplier.IsNil() && multiplier.IsNegative() {
+			return fmt.Errorf("reward tier multipliers must not be negative")
+		}
+	}
+	return nil
+}
+
+// Of returns the multiplier of tier
+func (m RewardTierMultipliers) Of(tier DonorTier) sdk.Dec {
+	var multiplier sdk.Dec
+	switch tier {
+	case TierBronze:
+		multiplier = m.Bronze
+	case TierSilver:
+		multiplier = m.Silver
+	case TierGold:
+		multiplier = m.Gold
+	case TierPlatinum:
+		multiplier = m.Platinum
+	}
+	if multiplier.IsNil() {
+		return sdk.OneDec()
+	}
+	return multiplier
+}
+
+// RewardDistribution is one allocation of the reward pool to donors
+type RewardDistribution struct {
+	ID         uint64
+	Admin      string
+	Allocated  sdk.Coins // total allocated to donors
+	Claimed    sdk.Coins
+	Recipients uint64
+	ExpiresAt  int64 // unix seconds; unclaimed rewards return to the pool
+	Expired    bool
+}
+
+// RewardAllocation is what one donor may claim from a distribution
+type RewardAllocation struct {
+	Amount sdk.Coins
+}
+
+// FundRewardPool moves coins from a sponsor into the reward pool
+func (k Keeper) FundRewardPool(ctx sdk.Context, sponsor string, amount sdk.Coins) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid funding amount")
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, ModuleName, amount); err != nil {
+		return err
+	}
+
+	pool := k.GetRewardPool(ctx)
+	pool.Balance = pool.Balance.Add(amount...)
+	k.setRewardPool(ctx, pool)
+
+	return ctx.EventManager().EmitTypedEvent(&EventRewardPoolFunded{
+		Sponsor: sponsor,
+		Amount:  amount,
+		Balance: pool.Balance,
+	})
+}
+
+// DistributeRewards allocates amount from the reward pool to all donors in
+// proportion to their lifetime totals, weighted like tiers and scaled by
+// their tier's multiplier. Shares are rounded down; the remainder stays in
+// the pool. Donors claim their share with ClaimReward until claimPeriod
+// seconds have passed, after which the EndBlocker returns what is left to
+// the pool. ADMIN only.
+func (k Keeper) DistributeRewards(
+	ctx sdk.Context,
+	admin string,
+	amount sdk.Coins,
+	claimPeriod int64,
+	multipliers RewardTierMultipliers,
+) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, admin, RoleAdmin) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ADMIN role required")
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid reward amount")
+	}
+	if claimPeriod <= 0 {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "claim period must be positive")
+	}
+	if err := multipliers.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	pool := k.GetRewardPool(ctx)
+	if !pool.Balance.IsAllGTE(amount) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "reward pool holds %s", pool.Balance)
+	}
+
+	// Weights are in LegacyDec fixed point, summed with big.Int so whale
+	// totals can't overflow
+	donors := k.GetAllDonors(ctx)
+	weights := make([]*big.Int, len(donors))
+	totalWeight := new(big.Int)
+	for i, donor := range donors {
+		tier := k.EffectiveTier(ctx, donor)
+		weights[i] = new(big.Int).Mul(multipliers.Of(tier).BigInt(), k.tierScore(ctx, donor.TotalDonated).BigInt())
+		totalWeight.Add(totalWeight, weights[i])
+	}
+	if totalWeight.Sign() == 0 {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no donor has a reward weight")
+	}
+
+	seq, err := k.rewardDistributionSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+	distribution := RewardDistribution{
+		ID:        seq + 1,
+		Admin:     admin,
+		Allocated: sdk.NewCoins(),
+		Claimed:   sdk.NewCoins(),
+		ExpiresAt: ctx.BlockTime().Unix() + claimPeriod,
+	}
+
+	for i, donor := range donors {
+		if weights[i].Sign() == 0 {
+			continue
+		}
+
+		share := sdk.NewCoins()
+		for _, coin := range amount {
+			part := new(big.Int).Mul(coin.Amount.BigInt(), weights[i])
+			part.Quo(part, totalWeight)
+			if part.Sign() > 0 {
+				share = share.Add(sdk.NewCoin(coin.Denom, sdk.NewIntFromBigInt(part)))
+			}
+		}
+		if share.IsZero() {
+			continue
+		}
+
+		key := collections.Join(distribution.ID, donor.Address)
+		if err := k.rewardAllocations.Set(ctx, key, RewardAllocation{Amount: share}); err != nil {
+			panic(err)
+		}
+		distribution.Allocated = distribution.Allocated.Add(share...)
+		distribution.Recipients++
+	}
+
+	pool.Balance = pool.Balance.Sub(distribution.Allocated...)
+	pool.Allocated = pool.Allocated.Add(distribution.Allocated...)
+	k.setRewardPool(ctx, pool)
+
+	if err := k.rewardDistributions.Set(ctx, distribution.ID, distribution); err != nil {
+		panic(err)
+	}
+	if err := k.rewardExpiryQueue.Set(ctx, collections.Join(uint64(distribution.ExpiresAt), distribution.ID)); err != nil {
+		panic(err)
+	}
+
+	if err := k.recordAudit(ctx, admin, AuditDistributeRewards, map[string]interface{}{
+		"distribution_id": distribution.ID,
+		"amount":          amount.String(),
+		"claim_period":    claimPeriod,
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventRewardsDistributed{
+		ID:         distribution.ID,
+		Admin:      admin,
+		Allocated:  distribution.Allocated,
+		Recipients: distribution.Recipients,
+		ExpiresAt:  distribution.ExpiresAt,
+	}); err != nil {
+		return 0, err
+	}
+
+	return distribution.ID, nil
+}
+
+// ClaimReward pays a donor their allocation from a distribution
+func (k Keeper) ClaimReward(ctx sdk.Context, donor string, distributionID uint64) (sdk.Coins, error) {
+	distribution, found := k.GetRewardDistribution(ctx, distributionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward distribution %d not found", distributionID)
+	}
+	if distribution.Expired {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reward distribution %d has expired", distributionID)
+	}
+
+	key := collections.Join(distributionID, donor)
+	allocation, err := k.rewardAllocations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no unclaimed reward for %s in distribution %d", donor, distributionID)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	donorAddr, err := sdk.AccAddressFromBech32(donor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, donorAddr, allocation.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.rewardAllocations.Remove(ctx, key); err != nil {
+		panic(err)
+	}
+	distribution.Claimed = distribution.Claimed.Add(allocation.Amount...)
+	if err := k.rewardDistributions.Set(ctx, distributionID, distribution); err != nil {
+		panic(err)
+	}
+
+	pool := k.GetRewardPool(ctx)
+	pool.Allocated = pool.Allocated.Sub(allocation.Amount...)
+	k.setRewardPool(ctx, pool)
+
+	record, _ := k.GetDonor(ctx, donor)
+	if err := ctx.EventManager().EmitTypedEvent(&EventRewardClaimed{
+		DistributionID: distributionID,
+		Donor:          publicDonor(record),
+		Amount:         allocation.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return allocation.Amount, nil
+}
+
+// expireRewards returns the unclaimed rewards of distributions past their
+// claim period to the pool. Called from EndBlocker.
+func (k Keeper) expireRewards(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndInclusive(collections.Join(uint64(ctx.BlockTime().Unix()), ^uint64(0)))
+	due, err := k.rewardExpiryQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		distribution, found := k.GetRewardDistribution(ctx, key.K2())
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward distribution %d", key.K2())
+		}
+
+		allocations, err := k.rewardAllocations.Iterate(ctx, collections.NewPrefixedPairRange[uint64, string](distribution.ID))
+		if err != nil {
+			return err
+		}
+		kvs, err := allocations.KeyValues()
+		if err != nil {
+			return err
+		}
+
+		unclaimed := sdk.NewCoins()
+		for _, kv := range kvs {
+			unclaimed = unclaimed.Add(kv.Value.Amount...)
+			if err := k.rewardAllocations.Remove(ctx, kv.Key); err != nil {
+				return err
+			}
+		}
+
+		pool := k.GetRewardPool(ctx)
+		pool.Allocated = pool.Allocated.Sub(unclaimed...)
+		pool.Balance = pool.Balance.Add(unclaimed...)
+		k.setRewardPool(ctx, pool)
+
+		distribution.Expired = true
+		if err := k.rewardDistributions.Set(ctx, distribution.ID, distribution); err != nil {
+			return err
+		}
+		if err := k.rewardExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&EventRewardsExpired{
+			ID:        distribution.ID,
+			Unclaimed: unclaimed,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRewardPool returns the reward pool
+func (k Keeper) GetRewardPool(ctx sdk.Context) RewardPool {
+	pool, err := k.rewardPool.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return RewardPool{Balance: sdk.NewCoins(), Allocated: sdk.NewCoins()}
+	}
+	if err != nil {
+		panic(err)
+	}
+	return pool
+}
+
+func (k Keeper) setRewardPool(ctx sdk.Context, pool RewardPool) {
+	if err := k.rewardPool.Set(ctx, pool); err != nil {
+		panic(err)
+	}
+}
+
+// GetRewardDistribution returns a reward distribution by ID
+func (k Keeper) GetRewardDistribution(ctx sdk.Context, id uint64) (RewardDistribution, bool) {
+	distribution, err := k.rewardDistributions.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return RewardDistribution{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return distribution, true
+}
+
+// GetRewardAllocation returns what a donor can still claim from a
+// distribution
+func (k Keeper) GetRewardAllocation(ctx sdk.Context, distributionID uint64, donor string) (sdk.Coins, bool) {
+	allocation, err := k.rewardAllocations.Get(ctx, collections.Join(distributionID, donor))
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return allocation.Amount, true
+}
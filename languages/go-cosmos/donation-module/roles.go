@@ -0,0 +1,153 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Role is an operator permission that can be granted to addresses
+type Role uint8
+
+const (
+	RoleAdmin      Role = 1 // may grant and revoke roles
+	RolePauser     Role = 2 // may pause and unpause
+	RoleWithdrawer Role = 3 // may withdraw and emergency withdraw
+	RoleAttester   Role = 4 // may record identity attestations
+)
+
+// String returns the role name
+func (r Role) String() string {
+	switch r {
+	case RoleAdmin:
+		return "ADMIN"
+	case RolePauser:
+		return "PAUSER"
+	case RoleWithdrawer:
+		return "WITHDRAWER"
+	case RoleAttester:
+		return "ATTESTER"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", uint8(r))
+	}
+}
+
+// IsValid reports whether r is a known role
+func (r Role) IsValid() bool {
+	return r >= RoleAdmin && r <= RoleAttester
+}
+
+// GetRoleKey returns the store key for a role grant
+func GetRoleKey(role Role, addr string) []byte {
+	return append(GetRolePrefix(role), []byte(addr)...)
+}
+
+// GetRolePrefix returns the store prefix for all holders of a role
+func GetRolePrefix(role Role) []byte {
+	return append(RoleKeyPrefix, byte(role))
+}
+
+// HasRole reports whether addr holds role. The state admin implicitly
+// holds every role.
+func (k Keeper) HasRole(ctx sdk.Context, addr string, role Role) bool {
+	if state, found := k.GetState(ctx); found && addr == state.Admin {
+		return true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetRoleKey(role, addr))
+}
+
+// GrantRole grants role to addr
+func (k Keeper) GrantRole(ctx sdk.Context, sender string, addr string, role Role) error {
+	if err := k.checkRoleUpdate(ctx, sender, addr, role); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetRoleKey(role, addr), []byte{})
+
+	if err := k.recordAudit(ctx, sender, AuditRoleGrant, map[string]interface{}{
+		"address": addr,
+		"role":    role.String(),
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventRoleGranted{
+		Sender:  sender,
+		Address: addr,
+		Role:    role.String(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RevokeRole revokes role from addr
+func (k Keeper) RevokeRole(ctx sdk.Context, sender string, addr string, role Role) error {
+	if err := k.checkRoleUpdate(ctx, sender, addr, role); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(GetRoleKey(role, addr)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "%s does not hold %s", addr, role)
+	}
+	store.Delete(GetRoleKey(role, addr))
+
+	if err := k.recordAudit(ctx, sender, AuditRoleRevoke, map[string]interface{}{
+		"address": addr,
+		"role":    role.String(),
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventRoleRevoked{
+		Sender:  sender,
+		Address: addr,
+		Role:    role.String(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetRoleMembers returns all addresses explicitly granted role
+func (k Keeper) GetRoleMembers(ctx sdk.Context, role Role) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetRolePrefix(role)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	members := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		members = append(members, string(iterator.Key()[len(prefix):]))
+	}
+
+	return members
+}
+
+func (k Keeper) checkRoleUpdate(ctx sdk.Context, sender string, addr string, role Role) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can manage roles")
+	}
+
+	if !role.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown role %d", role)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	donation "github.com/donation-contract/cosmos-donation"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the
+// KVPair's value to the corresponding donation type. Index and sequence
+// entries are printed as raw bytes.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], donation.StateKey):
+			var stateA, stateB donation.DonationState
+			cdc.MustUnmarshal(kvA.Value, &stateA)
+			cdc.MustUnmarshal(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", stateA, stateB)
+		case bytes.Equal(kvA.Key[:1], donation.DonorKeyPrefix):
+			var donorA, donorB donation.DonorRecord
+			cdc.MustUnmarshal(kvA.Value, &donorA)
+			cdc.MustUnmarshal(kvB.Value, &donorB)
+			return fmt.Sprintf("%v\n%v", donorA, donorB)
+		case bytes.Equal(kvA.Key[:1], donation.MatchingPoolKey):
+			var poolA, poolB donation.MatchingPool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.Equal(kvA.Key[:1], donation.CampaignKeyPrefix):
+			var campaignA, campaignB donation.Campaign
+			cdc.MustUnmarshal(kvA.Value, &campaignA)
+			cdc.MustUnmarshal(kvB.Value, &campaignB)
+			return fmt.Sprintf("%v\n%v", campaignA, campaignB)
+		case bytes.Equal(kvA.Key[:1], donation.ParamsKey):
+			var paramsA, paramsB donation.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], donation.DonationKeyPrefix):
+			var donationA, donationB donation.Donation
+			cdc.MustUnmarshal(kvA.Value, &donationA)
+			cdc.MustUnmarshal(kvB.Value, &donationB)
+			return fmt.Sprintf("%v\n%v", donationA, donationB)
+		case bytes.Equal(kvA.Key[:1], donation.TeamKeyPrefix):
+			var teamA, teamB donation.Team
+			cdc.MustUnmarshal(kvA.Value, &teamA)
+			cdc.MustUnmarshal(kvB.Value, &teamB)
+			return fmt.Sprintf("%v\n%v", teamA, teamB)
+		case bytes.Equal(kvA.Key[:1], donation.CampaignUpdateKeyPrefix):
+			var updateA, updateB donation.CampaignUpdate
+			cdc.MustUnmarshal(kvA.Value, &updateA)
+			cdc.MustUnmarshal(kvB.Value, &updateB)
+			return fmt.Sprintf("%v\n%v", updateA, updateB)
+		case bytes.Equal(kvA.Key[:1], donation.BeneficiariesKey):
+			var setA, setB donation.BeneficiarySet
+			cdc.MustUnmarshal(kvA.Value, &setA)
+			cdc.MustUnmarshal(kvB.Value, &setB)
+			return fmt.Sprintf("%v\n%v", setA, setB)
+		case bytes.Equal(kvA.Key[:1], donation.OrganizationKeyPrefix):
+			var orgA, orgB donation.Organization
+			cdc.MustUnmarshal(kvA.Value, &orgA)
+			cdc.MustUnmarshal(kvB.Value, &orgB)
+			return fmt.Sprintf("%v\n%v", orgA, orgB)
+		case bytes.Equal(kvA.Key[:1], donation.ModuleDelegationKeyPrefix):
+			var delegationA, delegationB donation.ModuleDelegation
+			cdc.MustUnmarshal(kvA.Value, &delegationA)
+			cdc.MustUnmarshal(kvB.Value, &delegationB)
+			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+		case bytes.Equal(kvA.Key[:1], donation.ModuleUnbondingQueuePrefix):
+			var unbondingA, unbondingB donation.ModuleUnbonding
+			cdc.MustUnmarshal(kvA.Value, &unbondingA)
+			cdc.MustUnmarshal(kvB.Value, &unbondingB)
+			return fmt.Sprintf("%v\n%v", unbondingA, unbondingB)
+		case bytes.Equal(kvA.Key[:1], donation.BoostKeyPrefix):
+			var boostA, boostB donation.Boost
+			cdc.MustUnmarshal(kvA.Value, &boostA)
+			cdc.MustUnmarshal(kvB.Value, &boostB)
+			return fmt.Sprintf("%v\n%v", boostA, boostB)
+		case bytes.Equal(kvA.Key[:1], donation.VestingScheduleKeyPrefix):
+			var scheduleA, scheduleB donation.VestingSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+		case bytes.Equal(kvA.Key[:1], donation.ChallengeMatchKeyPrefix):
+			var challengeA, challengeB donation.ChallengeMatch
+			cdc.MustUnmarshal(kvA.Value, &challengeA)
+			cdc.MustUnmarshal(kvB.Value, &challengeB)
+			return fmt.Sprintf("%v\n%v", challengeA, challengeB)
+		case bytes.Equal(kvA.Key[:1], donation.TierStatsKeyPrefix):
+			var statsA, statsB donation.TierStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+		case bytes.Equal(kvA.Key[:1], donation.DonationStatsKeyPrefix):
+			var bucketA, bucketB donation.DonationBucket
+			cdc.MustUnmarshal(kvA.Value, &bucketA)
+			cdc.MustUnmarshal(kvB.Value, &bucketB)
+			return fmt.Sprintf("%v\n%v", bucketA, bucketB)
+		default:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
+}
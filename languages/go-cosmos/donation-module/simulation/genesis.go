@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	donation "github.com/donation-contract/cosmos-donation"
+)
+
+// Simulation parameter keys
+const (
+	AnchorEpochBlocks = "anchor_epoch_blocks"
+	MinDonation       = "min_donation"
+)
+
+// RandomizedGenState generates a random GenesisState for the donation
+// module. The module is initialized with the first simulation account as
+// admin, so donation, withdrawal and pause operations are all reachable.
+func RandomizedGenState(simState *module.SimulationState) {
+	var anchorEpochBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AnchorEpochBlocks, &anchorEpochBlocks, simState.Rand,
+		func(r *rand.Rand) { anchorEpochBlocks = uint64(simtypes.RandIntBetween(r, 10, 200)) },
+	)
+
+	var minDonation int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinDonation, &minDonation, simState.Rand,
+		func(r *rand.Rand) { minDonation = int64(simtypes.RandIntBetween(r, 1, 10_000)) },
+	)
+
+	params := donation.DefaultParams()
+	params.AnchorEpochBlocks = anchorEpochBlocks
+	// Keep the simulation moving: short timelocks and immediate param changes
+	params.EmergencyWithdrawDelay = uint64(simtypes.RandIntBetween(simState.Rand, 1, 50))
+	params.ParamChangeDelay = 0
+	params.DonationLimits = donation.DonationLimits{{
+		Denom: sdk.DefaultBondDenom,
+		Min:   sdk.NewInt(minDonation),
+		Max:   sdk.NewInt(minDonation * 1_000),
+	}}
+
+	genesis := donation.GenesisState{
+		Params: params,
+		State: donation.DonationState{
+			Admin:          simState.Accounts[0].Address.String(),
+			TotalDonations: sdk.NewCoins(),
+			TotalWithdrawn: sdk.NewCoins(),
+			TotalBurned:    sdk.NewCoins(),
+			TotalFees:      sdk.NewCoins(),
+			TotalStaked:    sdk.NewCoins(),
+			TotalSlashed:   sdk.NewCoins(),
+			StakingRewards: sdk.NewCoins(),
+			TotalVesting:   sdk.NewCoins(),
+			HeldDonations:  sdk.NewCoins(),
+			Initialized:    true,
+		},
+		Donors: []donation.DonorRecord{},
+	}
+
+	simState.GenState[donation.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
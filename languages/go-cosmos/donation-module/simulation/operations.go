@@ -0,0 +1,201 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	donation "github.com/donation-contract/cosmos-donation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgDonate   = "op_weight_msg_donate"   //nolint:gosec
+	OpWeightMsgWithdraw = "op_weight_msg_withdraw" //nolint:gosec
+	OpWeightMsgPause    = "op_weight_msg_pause"    //nolint:gosec
+
+	WeightDonate   = 100
+	WeightWithdraw = 20
+	WeightPause    = 5
+)
+
+var (
+	TypeMsgDonate   = sdk.MsgTypeURL(&donation.MsgDonate{})
+	TypeMsgWithdraw = sdk.MsgTypeURL(&donation.MsgWithdraw{})
+	TypeMsgPause    = sdk.MsgTypeURL(&donation.MsgPause{})
+	TypeMsgUnpause  = sdk.MsgTypeURL(&donation.MsgUnpause{})
+)
+
+// AccountKeeper defines the account functionality used by the simulation
+type AccountKeeper interface {
+	simulation.AccountKeeper
+}
+
+// BankKeeper defines the bank functionality used by the simulation
+type BankKeeper interface {
+	simulation.BankKeeper
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak AccountKeeper,
+	bk BankKeeper,
+	k donation.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgDonate, weightMsgWithdraw, weightMsgPause int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDonate, &weightMsgDonate, nil,
+		func(_ *rand.Rand) { weightMsgDonate = WeightDonate },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdraw, &weightMsgWithdraw, nil,
+		func(_ *rand.Rand) { weightMsgWithdraw = WeightWithdraw },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgPause, &weightMsgPause, nil,
+		func(_ *rand.Rand) { weightMsgPause = WeightPause },
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgDonate, SimulateMsgDonate(protoCdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgWithdraw, SimulateMsgWithdraw(protoCdc, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgPause, SimulateMsgPause(protoCdc, ak, bk, k)),
+	}
+}
+
+// SimulateMsgDonate generates a MsgDonate of a random amount within the
+// donation limits from a random account
+func SimulateMsgDonate(cdc *codec.ProtoCodec, ak AccountKeeper, bk BankKeeper, k donation.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := k.GetState(ctx)
+		if !found || !state.Initialized || state.Paused {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgDonate, "donations not open"), nil, nil
+		}
+
+		donor, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, donor.Address)
+
+		// Donate one denom, between its min and max limit
+		limits := k.GetParams(ctx).DonationLimits
+		if len(limits) == 0 {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgDonate, "no donation limits"), nil, nil
+		}
+		limit := limits[r.Intn(len(limits))]
+		denom := limit.Denom
+		min := limit.Min
+		max := sdk.MinInt(limit.Max, spendable.AmountOf(denom))
+		if max.LT(min) {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgDonate, "insufficient funds"), nil, nil
+		}
+
+		amount := min.Add(simtypes.RandomAmount(r, max.Sub(min)))
+		msg := &donation.MsgDonate{
+			Donor:  donor.Address.String(),
+			Amount: sdk.NewCoins(sdk.NewCoin(denom, amount)),
+		}
+
+		return deliver(r, app, ctx, cdc, ak, bk, donor, msg, msg.Amount)
+	}
+}
+
+// SimulateMsgWithdraw generates a MsgWithdraw of part of the withdrawable
+// balance, sent by the admin to a random recipient
+func SimulateMsgWithdraw(cdc *codec.ProtoCodec, ak AccountKeeper, bk BankKeeper, k donation.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := k.GetState(ctx)
+		if !found || !state.Initialized {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgWithdraw, "not initialized"), nil, nil
+		}
+
+		admin, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(state.Admin))
+		if !found {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgWithdraw, "admin not a simulation account"), nil, nil
+		}
+
+		withdrawable := state.Withdrawable()
+		if withdrawable.IsZero() {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgWithdraw, "nothing to withdraw"), nil, nil
+		}
+
+		coin := withdrawable[r.Intn(len(withdrawable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgWithdraw, err.Error()), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg := &donation.MsgWithdraw{
+			Sender:    admin.Address.String(),
+			Amount:    sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)),
+			Recipient: recipient.Address.String(),
+		}
+
+		return deliver(r, app, ctx, cdc, ak, bk, admin, msg, nil)
+	}
+}
+
+// SimulateMsgPause toggles the pause state as the admin
+func SimulateMsgPause(cdc *codec.ProtoCodec, ak AccountKeeper, bk BankKeeper, k donation.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state, found := k.GetState(ctx)
+		if !found || !state.Initialized {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgPause, "not initialized"), nil, nil
+		}
+
+		admin, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(state.Admin))
+		if !found {
+			return simtypes.NoOpMsg(donation.ModuleName, TypeMsgPause, "admin not a simulation account"), nil, nil
+		}
+
+		var msg sdk.Msg = &donation.MsgPause{Sender: admin.Address.String()}
+		if state.Paused {
+			msg = &donation.MsgUnpause{Sender: admin.Address.String()}
+		}
+
+		return deliver(r, app, ctx, cdc, ak, bk, admin, msg, nil)
+	}
+}
+
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	cdc *codec.ProtoCodec,
+	ak AccountKeeper,
+	bk BankKeeper,
+	account simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      donation.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
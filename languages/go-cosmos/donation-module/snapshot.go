@@ -0,0 +1,174 @@
+package donation
+
+import (
+	"bytes"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DonorSnapshot commits to the donor set at a height for airdrops. Unlike
+// epoch anchors, snapshots are taken on request, at a height governance or
+// an ADMIN chooses.
+type DonorSnapshot struct {
+	ID         uint64
+	Creator    string
+	Height     int64
+	Root       []byte // nil until the snapshot is taken
+	DonorCount uint64
+}
+
+// Taken reports whether the snapshot's root has been computed
+func (s DonorSnapshot) Taken() bool {
+	return s.Root != nil
+}
+
+// ScheduleDonorSnapshot schedules a snapshot of the donor set at the end
+// of block height, or of the current block if height is 0. The EndBlocker
+// computes the Merkle root over (address, total, tier) leaves once all of
+// that block's donations are in, so proofs rebuilt from the committed
+// state at that height match it. Governance or ADMIN only.
+func (k Keeper) ScheduleDonorSnapshot(ctx sdk.Context, sender string, height int64) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if sender != k.authority && !k.HasRole(ctx, sender, RoleAdmin) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only governance or ADMIN can snapshot donors")
+	}
+
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	if height < ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "snapshot height %d is in the past", height)
+	}
+
+	seq, err := k.snapshotSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	snapshot := DonorSnapshot{
+		ID:      seq + 1,
+		Creator: sender,
+		Height:  height,
+	}
+	if err := k.snapshots.Set(ctx, snapshot.ID, snapshot); err != nil {
+		panic(err)
+	}
+	if err := k.snapshotQueue.Set(ctx, collections.Join(uint64(height), snapshot.ID)); err != nil {
+		panic(err)
+	}
+
+	if err := k.recordAudit(ctx, sender, AuditScheduleSnapshot, map[string]interface{}{
+		"snapshot_id": snapshot.ID,
+		"height":      height,
+	}); err != nil {
+		return 0, err
+	}
+
+	return snapshot.ID, nil
+}
+
+// takeDueSnapshots computes the roots of the snapshots scheduled for this
+// block. Called at the end of EndBlocker.
+func (k Keeper) takeDueSnapshots(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(uint64(ctx.BlockHeight())+1, uint64(0)))
+	due, err := k.snapshotQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := due.Keys()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	donors := k.GetAllDonors(ctx)
+	root := merkleRoot(donorLeaves(donors))
+
+	for _, key := range keys {
+		snapshot, found := k.GetDonorSnapshot(ctx, key.K2())
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor snapshot %d", key.K2())
+		}
+
+		snapshot.Height = ctx.BlockHeight()
+		snapshot.Root = root
+		snapshot.DonorCount = uint64(len(donors))
+		if err := k.snapshots.Set(ctx, snapshot.ID, snapshot); err != nil {
+			return err
+		}
+		if err := k.snapshotQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&EventDonorSnapshotTaken{
+			ID:         snapshot.ID,
+			Creator:    snapshot.Creator,
+			Height:     snapshot.Height,
+			Root:       snapshot.Root,
+			DonorCount: snapshot.DonorCount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDonorSnapshot returns a donor snapshot by ID
+func (k Keeper) GetDonorSnapshot(ctx sdk.Context, id uint64) (DonorSnapshot, bool) {
+	snapshot, err := k.snapshots.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return DonorSnapshot{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return snapshot, true
+}
+
+// GetDonorSnapshotProof returns a donor's record in a snapshot and its
+// inclusion proof, for VerifyMerkleProof(root, DonorLeaf(record), proof).
+// Like donor-set proofs, the set is rebuilt from the state at the snapshot
+// height, so ctx must be a query context at that height, which the node
+// must still retain.
+func (k Keeper) GetDonorSnapshotProof(
+	ctx sdk.Context,
+	id uint64,
+	addr string,
+) (DonorRecord, []MerkleProofStep, error) {
+	snapshot, found := k.GetDonorSnapshot(ctx, id)
+	if !found {
+		return DonorRecord{}, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "donor snapshot %d not found", id)
+	}
+	if !snapshot.Taken() {
+		return DonorRecord{}, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "donor snapshot %d is scheduled for height %d", id, snapshot.Height)
+	}
+
+	if err := checkQueryHeight(ctx, snapshot.Height); err != nil {
+		return DonorRecord{}, nil, err
+	}
+
+	donors := k.GetAllDonors(ctx)
+	leaves := donorLeaves(donors)
+	if !bytes.Equal(merkleRoot(leaves), snapshot.Root) {
+		return DonorRecord{}, nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "donor set at height %d does not match snapshot %d", snapshot.Height, id)
+	}
+
+	for i, donor := range donors {
+		if donor.Address == addr {
+			return donor, merkleProof(leaves, i), nil
+		}
+	}
+
+	return DonorRecord{}, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "%s not in donor snapshot %d", addr, id)
+}
@@ -0,0 +1,391 @@
+package donation
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ModuleDelegation tracks donation funds the module has delegated to a
+// validator, in bond denom tokens
+type ModuleDelegation struct {
+	Validator string
+	Amount    sdk.Int
+}
+
+// ModuleUnbonding tracks undelegated funds until the staking module
+// returns them to the module account
+type ModuleUnbonding struct {
+	Validator      string
+	Amount         sdk.Int
+	CompletionTime int64 // unix seconds
+}
+
+// validateMaxStakedFraction checks that the staking cap is in [0, 1]; nil
+// means staking is off
+func validateMaxStakedFraction(fraction sdk.Dec) error {
+	if fraction.IsNil() {
+		return nil
+	}
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("max staked fraction must be in [0, 1]")
+	}
+	return nil
+}
+
+// GetModuleDelegationKey returns the store key of the module's delegation
+// to a validator
+func GetModuleDelegationKey(validator string) []byte {
+	return append(ModuleDelegationKeyPrefix, address.MustLengthPrefix([]byte(validator))...)
+}
+
+// GetModuleUnbondingKey returns the key of an unbonding in the queue,
+// ordered by completion time
+func GetModuleUnbondingKey(completionTime int64, validator string) []byte {
+	key := append(ModuleUnbondingQueuePrefix, sdk.Uint64ToBigEndian(uint64(completionTime))...)
+	return append(key, address.MustLengthPrefix([]byte(validator))...)
+}
+
+// GetModuleDelegation returns the tracked delegation to validator
+func (k Keeper) GetModuleDelegation(ctx sdk.Context, validator string) (ModuleDelegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetModuleDelegationKey(validator))
+	if bz == nil {
+		return ModuleDelegation{}, false
+	}
+
+	var delegation ModuleDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+func (k Keeper) setModuleDelegation(ctx sdk.Context, delegation ModuleDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	if !delegation.Amount.IsPositive() {
+		store.Delete(GetModuleDelegationKey(delegation.Validator))
+		return
+	}
+	store.Set(GetModuleDelegationKey(delegation.Validator), k.cdc.MustMarshal(&delegation))
+}
+
+// GetAllModuleDelegations returns every tracked delegation
+func (k Keeper) GetAllModuleDelegations(ctx sdk.Context) []ModuleDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ModuleDelegationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	delegations := []ModuleDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation ModuleDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetAllModuleUnbondings returns every pending unbonding, earliest first
+func (k Keeper) GetAllModuleUnbondings(ctx sdk.Context) []ModuleUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ModuleUnbondingQueuePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	unbondings := []ModuleUnbonding{}
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding ModuleUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+// setModuleUnbonding queues an unbonding; undelegations from the same
+// validator maturing at the same time are merged
+func (k Keeper) setModuleUnbonding(ctx sdk.Context, unbonding ModuleUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetModuleUnbondingKey(unbonding.CompletionTime, unbonding.Validator)
+
+	if bz := store.Get(key); bz != nil {
+		var existing ModuleUnbonding
+		k.cdc.MustUnmarshal(bz, &existing)
+		unbonding.Amount = unbonding.Amount.Add(existing.Amount)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&unbonding))
+}
+
+// DelegateFunds delegates idle donation funds to a validator. Only ADMIN
+// may delegate, and only up to Params.MaxStakedFraction of the donations
+// held in the bond denom, staked funds included.
+func (k Keeper) DelegateFunds(ctx sdk.Context, sender, validator string, amount sdk.Coin) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can delegate funds")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s not found", validator)
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom || !amount.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "can only delegate a positive amount of %s", bondDenom)
+	}
+
+	maxFraction := k.GetParams(ctx).MaxStakedFraction
+	if maxFraction.IsNil() || !maxFraction.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking is disabled")
+	}
+
+	staked := state.TotalStaked.AmountOf(bondDenom)
+	held := state.Withdrawable().AmountOf(bondDenom).Add(staked)
+	if staked.Add(amount.Amount).GT(maxFraction.MulInt(held).TruncateInt()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "delegation would stake more than %s of the donations", maxFraction)
+	}
+
+	// Delegating withdraws pending rewards; claim them first so they are
+	// credited to the donations
+	if err := k.claimRewards(ctx, &state, validator); err != nil {
+		return err
+	}
+
+	moduleAddr := k.moduleAddress()
+	if _, err := k.stakingKeeper.Delegate(ctx, moduleAddr, amount.Amount, stakingtypes.Unbonded, val, true); err != nil {
+		return err
+	}
+
+	delegation, found := k.GetModuleDelegation(ctx, validator)
+	if !found {
+		delegation = ModuleDelegation{Validator: validator, Amount: sdk.ZeroInt()}
+	}
+	delegation.Amount = delegation.Amount.Add(amount.Amount)
+	k.setModuleDelegation(ctx, delegation)
+
+	state.TotalStaked = state.TotalStaked.Add(amount)
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, sender, AuditDelegate, map[string]interface{}{
+		"validator": validator,
+		"amount":    amount.String(),
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventFundsDelegated{
+		Sender:    sender,
+		Validator: validator,
+		Amount:    amount,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UndelegateFunds starts unbonding delegated funds, e.g. ahead of a large
+// withdrawal, and returns when the unbonding completes. The funds stay out
+// of the withdrawable balance until then. Only ADMIN may undelegate.
+func (k Keeper) UndelegateFunds(ctx sdk.Context, sender, validator string, amount sdk.Coin) (int64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can undelegate funds")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	delegation, found := k.GetModuleDelegation(ctx, validator)
+	if !found {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no delegation to %s", validator)
+	}
+
+	if amount.Denom != k.stakingKeeper.BondDenom(ctx) || !amount.Amount.IsPositive() {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid undelegation amount %s", amount)
+	}
+
+	moduleAddr := k.moduleAddress()
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, moduleAddr, valAddr, amount.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.claimRewards(ctx, &state, validator); err != nil {
+		return 0, err
+	}
+
+	completion, err := k.stakingKeeper.Undelegate(ctx, moduleAddr, valAddr, shares)
+	if err != nil {
+		return 0, err
+	}
+
+	k.setModuleUnbonding(ctx, ModuleUnbonding{
+		Validator:      validator,
+		Amount:         amount.Amount,
+		CompletionTime: completion.Unix(),
+	})
+
+	// Once the whole delegation is gone, whatever is still tracked was lost
+	// to slashing and is written off
+	delegation.Amount = delegation.Amount.Sub(amount.Amount)
+	if _, stillDelegated := k.stakingKeeper.GetDelegation(ctx, moduleAddr, valAddr); !stillDelegated && delegation.Amount.IsPositive() {
+		slashed := sdk.NewCoin(amount.Denom, delegation.Amount)
+		state.TotalStaked = state.TotalStaked.Sub(slashed)
+		state.TotalSlashed = state.TotalSlashed.Add(slashed)
+		delegation.Amount = sdk.ZeroInt()
+	}
+	k.setModuleDelegation(ctx, delegation)
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, sender, AuditUndelegate, map[string]interface{}{
+		"validator": validator,
+		"amount":    amount.String(),
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventFundsUndelegated{
+		Sender:         sender,
+		Validator:      validator,
+		Amount:         amount,
+		CompletionTime: completion.Unix(),
+	}); err != nil {
+		return 0, err
+	}
+
+	return completion.Unix(), nil
+}
+
+// ClaimStakingRewards withdraws the rewards of every delegation into the
+// donations. Only ADMIN may claim.
+func (k Keeper) ClaimStakingRewards(ctx sdk.Context, sender string) (sdk.Coins, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can claim staking rewards")
+	}
+
+	before := state.StakingRewards
+	for _, delegation := range k.GetAllModuleDelegations(ctx) {
+		if err := k.claimRewards(ctx, &state, delegation.Validator); err != nil {
+			return nil, err
+		}
+	}
+	k.SetState(ctx, state)
+
+	claimed := state.StakingRewards.Sub(before...)
+	if err := k.recordAudit(ctx, sender, AuditClaimStakingRewards, map[string]interface{}{
+		"amount": claimed.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// claimRewards withdraws the rewards of the module's delegation to
+// validator, if any, and credits them to the donations
+func (k Keeper) claimRewards(ctx sdk.Context, state *DonationState, validator string) error {
+	if _, found := k.GetModuleDelegation(ctx, validator); !found {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(ctx, k.moduleAddress(), valAddr)
+	if err != nil {
+		return err
+	}
+	if rewards.IsZero() {
+		return nil
+	}
+
+	state.TotalDonations = state.TotalDonations.Add(rewards...)
+	state.StakingRewards = state.StakingRewards.Add(rewards...)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventStakingRewardsClaimed{
+		Validator: validator,
+		Amount:    rewards,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// completeUnbondings releases matured unbondings back into the withdrawable
+// balance. It must run after the staking module's EndBlocker has paid them
+// out to the module account.
+func (k Keeper) completeUnbondings(ctx sdk.Context) error {
+	now := ctx.BlockTime().Unix()
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ModuleUnbondingQueuePrefix)
+	var matured []ModuleUnbonding
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding ModuleUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		// Completion times are truncated to seconds; wait for the next second
+		// so the staking module has certainly paid out
+		if unbonding.CompletionTime >= now {
+			break
+		}
+		matured = append(matured, unbonding)
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	if len(matured) == 0 {
+		return nil
+	}
+
+	state, found := k.GetState(ctx)
+	if !found {
+		return nil
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for i, unbonding := range matured {
+		store.Delete(keys[i])
+
+		released := sdk.NewCoin(bondDenom, sdk.MinInt(unbonding.Amount, state.TotalStaked.AmountOf(bondDenom)))
+		state.TotalStaked = state.TotalStaked.Sub(released)
+
+		if err := ctx.EventManager().EmitTypedEvent(&EventUnbondingCompleted{
+			Validator: unbonding.Validator,
+			Amount:    released,
+		}); err != nil {
+			return err
+		}
+	}
+	k.SetState(ctx, state)
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Team groups donors for peer-to-peer fundraising. Member donations are
+// aggregated into the team total; a team tied to a campaign only counts
+// its members' donations to that campaign.
+type Team struct {
+	ID          uint64
+	Name        string
+	Creator     string
+	CampaignID  uint64 // 0 counts all member donations
+	MemberCount uint64
+	Total       sdk.Coins
+}
+
+// GetTeamKey returns the store key for a team
+func GetTeamKey(id uint64) []byte {
+	return append(TeamKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTeamMembershipKey returns the key holding the team a donor belongs to
+func GetTeamMembershipKey(member string) []byte {
+	return append(TeamMembershipKeyPrefix, []byte(member)...)
+}
+
+// GetTeamMemberKey returns the team-member index key of a member
+func GetTeamMemberKey(teamID uint64, member string) []byte {
+	return append(GetTeamMembersPrefix(teamID), []byte(member)...)
+}
+
+// GetTeamMembersPrefix returns the prefix of a team's member index
+func GetTeamMembersPrefix(teamID uint64) []byte {
+	return append(TeamMemberKeyPrefix, sdk.Uint64ToBigEndian(teamID)...)
+}
+
+// GetTeamLeaderboardKey returns the team leaderboard index key of a team
+func GetTeamLeaderboardKey(score sdk.Int, teamID uint64) []byte {
+	return rankedKey(TeamLeaderboardKeyPrefix, score, sdk.Uint64ToBigEndian(teamID))
+}
+
+// GetTeamLeaderboardPositionKey returns the key holding a team's current
+// leaderboard index key
+func GetTeamLeaderboardPositionKey(teamID uint64) []byte {
+	return append(TeamLeaderboardPositionKeyPrefix, sdk.Uint64ToBigEndian(teamID)...)
+}
+
+// CreateTeam registers a team, optionally tied to a campaign, and makes
+// the creator its first member
+func (k Keeper) CreateTeam(ctx sdk.Context, creator string, name string, campaignID uint64) (uint64, error) {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if name == "" {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "team name required")
+	}
+
+	if campaignID != 0 {
+		campaign, found := k.GetCampaign(ctx, campaignID)
+		if !found {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "campaign %d not found", campaignID)
+		}
+		if campaign.Archived {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "campaign %d is archived", campaignID)
+		}
+	}
+
+	if _, found := k.GetMemberTeam(ctx, creator); found {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "already a member of a team")
+	}
+
+	team := Team{
+		ID:         k.nextTeamID(ctx),
+		Name:       name,
+		Creator:    creator,
+		CampaignID: campaignID,
+		Total:      sdk.NewCoins(),
+	}
+	k.addTeamMember(ctx, &team, creator)
+	k.SetTeam(ctx, team)
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventTeamCreated{
+		TeamID:     team.ID,
+		Name:       name,
+		Creator:    creator,
+		CampaignID: campaignID,
+	}); err != nil {
+		return 0, err
+	}
+
+	return team.ID, nil
+}
+
+// JoinTeam adds a donor to a team. Donors belong to at most one team and
+// only donations made after joining count toward the team total.
+func (k Keeper) JoinTeam(ctx sdk.Context, member string, teamID uint64) error {
+	team, found := k.GetTeam(ctx, teamID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "team %d not found", teamID)
+	}
+
+	if _, found := k.GetMemberTeam(ctx, member); found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "already a member of a team")
+	}
+
+	k.addTeamMember(ctx, &team, member)
+	k.SetTeam(ctx, team)
+
+	return ctx.EventManager().EmitTypedEvent(&EventTeamJoined{
+		TeamID: teamID,
+		Member: member,
+	})
+}
+
+// LeaveTeam removes a donor from their team. The team keeps the donations
+// already credited.
+func (k Keeper) LeaveTeam(ctx sdk.Context, member string) error {
+	teamID, found := k.GetMemberTeam(ctx, member)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "not a member of a team")
+	}
+
+	team, _ := k.GetTeam(ctx, teamID)
+	team.MemberCount--
+	k.SetTeam(ctx, team)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetTeamMembershipKey(member))
+	store.Delete(GetTeamMemberKey(teamID, member))
+
+	return ctx.EventManager().EmitTypedEvent(&EventTeamLeft{
+		TeamID: teamID,
+		Member: member,
+	})
+}
+
+// creditTeam adds a donation to the donor's team total and returns the
+// team's ID, or 0 if no team was credited. campaignID is the campaign the
+// donation was directed to, 0 for the general donation step.
+func (k Keeper) creditTeam(ctx sdk.Context, donor string, campaignID uint64, amount sdk.Coins) uint64 {
+	teamID, found := k.GetMemberTeam(ctx, donor)
+	if !found {
+		return 0
+	}
+
+	team, found := k.GetTeam(ctx, teamID)
+	if !found || team.CampaignID != campaignID {
+		return 0
+	}
+
+	team.Total = team.Total.Add(amount...)
+	k.SetTeam(ctx, team)
+	return teamID
+}
+
+// debitTeam takes a refunded donation off the total of the team it was
+// credited to, flooring each denom at zero
+func (k Keeper) debitTeam(ctx sdk.Context, teamID uint64, amount sdk.Coins) {
+	team, found := k.GetTeam(ctx, teamID)
+	if !found {
+		return
+	}
+
+	remaining, _ := team.Total.SafeSub(amount...)
+	team.Total = positiveCoins(remaining)
+	k.SetTeam(ctx, team)
+}
+
+// GetTeam retrieves a team
+func (k Keeper) GetTeam(ctx sdk.Context, id uint64) (Team, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTeamKey(id))
+	if bz == nil {
+		return Team{}, false
+	}
+
+	var team Team
+	k.cdc.MustUnmarshal(bz, &team)
+	return team, true
+}
+
+// SetTeam stores a team and keeps the team leaderboard in sync
+func (k Keeper) SetTeam(ctx sdk.Context, team Team) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&team)
+	store.Set(GetTeamKey(team.ID), bz)
+
+	score := k.tierScore(ctx, team.Total)
+	k.setRankedEntry(
+		ctx,
+		GetTeamLeaderboardPositionKey(team.ID),
+		GetTeamLeaderboardKey(score, team.ID),
+		score.IsPositive(),
+	)
+}
+
+// GetMemberTeam returns the ID of the team a donor belongs to
+func (k Keeper) GetMemberTeam(ctx sdk.Context, member string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTeamMembershipKey(member))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// GetTeamMembers returns the addresses of a team's members
+func (k Keeper) GetTeamMembers(ctx sdk.Context, teamID uint64) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetTeamMembersPrefix(teamID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	members := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		members = append(members, string(iterator.Key()[len(prefix):]))
+	}
+
+	return members
+}
+
+// QueryTeamLeaderboard returns the top teams by total, highest first, with
+// the same limit semantics as QueryLeaderboard
+func (k Keeper) QueryTeamLeaderboard(ctx sdk.Context, limit uint32) []Team {
+	if limit == 0 {
+		limit = DefaultLeaderboardLimit
+	}
+	if limit > MaxLeaderboardLimit {
+		limit = MaxLeaderboardLimit
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, TeamLeaderboardKeyPrefix)
+	defer iterator.Close()
+
+	teams := []Team{}
+	for ; iterator.Valid() && uint32(len(teams)) < limit; iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(TeamLeaderboardKeyPrefix)+leaderboardScoreLen:])
+		if team, found := k.GetTeam(ctx, id); found {
+			teams = append(teams, team)
+		}
+	}
+
+	return teams
+}
+
+func (k Keeper) addTeamMember(ctx sdk.Context, team *Team, member string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetTeamMembershipKey(member), sdk.Uint64ToBigEndian(team.ID))
+	store.Set(GetTeamMemberKey(team.ID, member), []byte{})
+	team.MemberCount++
+}
+
+// nextTeamID returns the next team ID and advances the sequence
+func (k Keeper) nextTeamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(TeamSeqKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(TeamSeqKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
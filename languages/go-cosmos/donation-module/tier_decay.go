@@ -0,0 +1,104 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TierDecay depreciates a donor's tier contribution by Rate for every full
+// epoch without a donation. Tiers are recomputed lazily: on the donor's
+// next donation and whenever a query reads the donor.
+type TierDecay struct {
+	EpochSeconds int64   // zero disables decay
+	Rate         sdk.Dec // fraction lost per epoch, in (0, 1)
+}
+
+// IsSet reports whether tier decay is enabled
+func (d TierDecay) IsSet() bool {
+	return d.EpochSeconds > 0
+}
+
+// Validate performs basic validation of the decay policy
+func (d TierDecay) Validate() error {
+	if !d.IsSet() {
+		return nil
+	}
+	if d.Rate.IsNil() || !d.Rate.IsPositive() || d.Rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("tier decay rate must be in (0, 1)")
+	}
+	return nil
+}
+
+// Factor returns the share of a contribution left after elapsed seconds
+func (d TierDecay) Factor(elapsed int64) sdk.Dec {
+	if !d.IsSet() || elapsed < d.EpochSeconds {
+		return sdk.OneDec()
+	}
+	return sdk.OneDec().Sub(d.Rate).Power(uint64(elapsed / d.EpochSeconds))
+}
+
+// contributionBasis returns the donor's undecayed tier contribution and the
+// time it was last topped up. Records from before decay existed fall back
+// to their lifetime totals, aged from the first donation.
+func contributionBasis(donor DonorRecord) (sdk.Coins, sdk.Dec, int64) {
+	if donor.LastDonation == 0 {
+		return donor.TotalDonated, donor.TotalUSD, donor.FirstDonation
+	}
+	return donor.Contribution, donor.ContributionUSD, donor.LastDonation
+}
+
+// effectiveContribution returns the donor's tier contribution decayed to now
+func (k Keeper) effectiveContribution(ctx sdk.Context, donor DonorRecord) (sdk.Coins, sdk.Dec) {
+	coins, usd, since := contributionBasis(donor)
+	if usd.IsNil() {
+		usd = sdk.ZeroDec()
+	}
+
+	factor := k.GetParams(ctx).TierDecay.Factor(ctx.BlockTime().Unix() - since)
+	if factor.Equal(sdk.OneDec()) {
+		return coins, usd
+	}
+
+	decayed, _ := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(factor).TruncateDecimal()
+	return decayed, usd.Mul(factor)
+}
+
+// addContribution decays the donor's contribution to now and adds a new
+// donation, restarting the decay clock
+func (k Keeper) addContribution(ctx sdk.Context, donor *DonorRecord, amount sdk.Coins, usd sdk.Dec) {
+	coins, total := k.effectiveContribution(ctx, *donor)
+	donor.Contribution = coins.Add(amount...)
+	donor.ContributionUSD = addUSD(total, usd)
+	donor.LastDonation = ctx.BlockTime().Unix()
+}
+
+// subContribution takes a refund off the donor's contribution, flooring
+// each denom at zero; the decay clock is left alone
+func subContribution(donor *DonorRecord, amount sdk.Coins, usd sdk.Dec) {
+	coins, total, since := contributionBasis(*donor)
+
+	remaining := sdk.NewCoins()
+	for _, coin := range coins {
+		if left := coin.Amount.Sub(amount.AmountOf(coin.Denom)); left.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, left))
+		}
+	}
+
+	donor.Contribution = remaining
+	donor.ContributionUSD = addUSD(total, usd.Neg())
+	donor.LastDonation = since
+}
+
+// EffectiveTier returns the donor's tier with decay applied as of the
+// current block. The stored Tier is only refreshed by donations and
+// refunds, so queries should report this instead.
+func (k Keeper) EffectiveTier(ctx sdk.Context, donor DonorRecord) DonorTier {
+	return k.donorTier(ctx, donor)
+}
+
+// withEffectiveTier returns the donor record with its tier brought up to date
+func (k Keeper) withEffectiveTier(ctx sdk.Context, donor DonorRecord) DonorRecord {
+	donor.Tier = k.EffectiveTier(ctx, donor)
+	return donor
+}
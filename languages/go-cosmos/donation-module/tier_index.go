@@ -0,0 +1,83 @@
+package donation
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/donation-contract/cosmos-donation/migrations"
+)
+
+// GetTierDonorsPrefix returns the prefix of the donor index for a tier
+func GetTierDonorsPrefix(tier DonorTier) []byte {
+	return append(append([]byte{}, TierIndexKeyPrefix...), byte(tier))
+}
+
+// donorIndexes are the secondary indexes of the donors collection
+type donorIndexes struct {
+	// Tier indexes donors by their stored tier. Its keys keep the raw
+	// address after the tier byte, the layout the index had before
+	// collections, so it is read with the plain string codec.
+	Tier *indexes.Multi[DonorTier, string, DonorRecord]
+}
+
+// IndexesList implements collections.Indexes
+func (i donorIndexes) IndexesList() []collections.Index[string, DonorRecord] {
+	return []collections.Index[string, DonorRecord]{i.Tier}
+}
+
+func newDonorIndexes(sb *collections.SchemaBuilder) donorIndexes {
+	return donorIndexes{
+		Tier: indexes.NewMulti(
+			sb, collections.NewPrefix(TierIndexKeyPrefix), "donors_by_tier",
+			tierKey{}, collections.StringKey,
+			func(_ string, donor DonorRecord) (DonorTier, error) {
+				return donor.Tier, nil
+			},
+		),
+	}
+}
+
+// QueryDonorsByTier returns a page of the donors in a tier, ordered by
+// address, with anonymous donors redacted. Donors are selected by their
+// stored tier and returned with it, undecayed, so every record matches the
+// tier asked for; QueryDonor reports the effective tier.
+func (k Keeper) QueryDonorsByTier(
+	ctx sdk.Context,
+	tier DonorTier,
+	pageReq *query.PageRequest,
+) ([]DonorRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetTierDonorsPrefix(tier))
+
+	donors := []DonorRecord{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		if donor, found := k.GetDonor(ctx, string(key)); found {
+			donors = append(donors, donor.Public())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return donors, pageRes, nil
+}
+
+// rebuildDonorIndexes rewrites the leaderboard and tier index from the
+// donor records, dropping any existing entries first
+func (k Keeper) rebuildDonorIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	migrations.DeletePrefix(store, LeaderboardKeyPrefix)
+	migrations.DeletePrefix(store, LeaderboardPositionKeyPrefix)
+	migrations.DeletePrefix(store, TierIndexKeyPrefix)
+
+	// Setting a record again rewrites its tier index entry
+	for _, donor := range k.GetAllDonors(ctx) {
+		if err := k.donors.Set(ctx, donor.Address, donor); err != nil {
+			panic(err)
+		}
+		k.updateLeaderboard(ctx, donor)
+	}
+}
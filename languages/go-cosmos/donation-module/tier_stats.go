@@ -0,0 +1,84 @@
+package donation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TierStats aggregates the donors currently in a tier
+type TierStats struct {
+	Tier         DonorTier
+	DonorCount   uint64
+	TotalDonated sdk.Coins // lifetime totals of the tier's donors
+}
+
+// GetTierStatsKey returns the store key of a tier's stats
+func GetTierStatsKey(tier DonorTier) []byte {
+	return append(append([]byte{}, TierStatsKeyPrefix...), byte(tier))
+}
+
+// GetTierStats returns the stats of a tier, empty if it has no donors
+func (k Keeper) GetTierStats(ctx sdk.Context, tier DonorTier) TierStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetTierStatsKey(tier))
+	if bz == nil {
+		return TierStats{Tier: tier, TotalDonated: sdk.NewCoins()}
+	}
+
+	var stats TierStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+func (k Keeper) setTierStats(ctx sdk.Context, stats TierStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetTierStatsKey(stats.Tier), k.cdc.MustMarshal(&stats))
+}
+
+// updateTierStats moves a donor's previous record out of its tier's stats
+// and adds the new one, so the counters follow every donor record write
+func (k Keeper) updateTierStats(ctx sdk.Context, previous DonorRecord, existed bool, donor DonorRecord) {
+	if existed {
+		stats := k.GetTierStats(ctx, previous.Tier)
+		if stats.DonorCount > 0 {
+			stats.DonorCount--
+		}
+		remaining, _ := stats.TotalDonated.SafeSub(previous.TotalDonated...)
+		stats.TotalDonated = positiveCoins(remaining)
+		k.setTierStats(ctx, stats)
+	}
+
+	stats := k.GetTierStats(ctx, donor.Tier)
+	stats.DonorCount++
+	stats.TotalDonated = stats.TotalDonated.Add(donor.TotalDonated...)
+	k.setTierStats(ctx, stats)
+}
+
+// QueryTierStats returns the donor count and lifetime total of every tier,
+// from TierNone up. Donors are counted in their stored tier, like the tier
+// index, so tier decay shows up once they donate again.
+func (k Keeper) QueryTierStats(ctx sdk.Context) []TierStats {
+	stats := make([]TierStats, 0, int(TierPlatinum)+1)
+	for tier := TierNone; tier <= TierPlatinum; tier++ {
+		stats = append(stats, k.GetTierStats(ctx, tier))
+	}
+	return stats
+}
+
+// rebuildTierStats recomputes every tier's stats from the donor records
+func (k Keeper) rebuildTierStats(ctx sdk.Context) {
+	stats := make(map[DonorTier]TierStats)
+	for tier := TierNone; tier <= TierPlatinum; tier++ {
+		stats[tier] = TierStats{Tier: tier, TotalDonated: sdk.NewCoins()}
+	}
+
+	for _, donor := range k.GetAllDonors(ctx) {
+		tierStats := stats[donor.Tier]
+		tierStats.DonorCount++
+		tierStats.TotalDonated = tierStats.TotalDonated.Add(donor.TotalDonated...)
+		stats[donor.Tier] = tierStats
+	}
+
+	for tier := TierNone; tier <= TierPlatinum; tier++ {
+		k.setTierStats(ctx, stats[tier])
+	}
+}
@@ -0,0 +1,94 @@
+package donation
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTierForScore(t *testing.T) {
+	tests := []struct {
+		score sdkmath.Int
+		want  DonorTier
+	}{
+		{sdkmath.ZeroInt(), TierNone},
+		{sdkmath.NewInt(9_999), TierNone},
+		{sdkmath.NewInt(10_000), TierBronze},
+		{sdkmath.NewInt(99_999), TierBronze},
+		{sdkmath.NewInt(100_000), TierSilver},
+		{sdkmath.NewInt(999_999), TierSilver},
+		{sdkmath.NewInt(1_000_000), TierGold},
+		{sdkmath.NewInt(9_999_999), TierGold},
+		{sdkmath.NewInt(10_000_000), TierPlatinum},
+		{maxTierScore, TierPlatinum},
+	}
+
+	for _, tc := range tests {
+		if got := tierForScore(tc.score); got != tc.want {
+			t.Errorf("tierForScore(%s) = %s, want %s", tc.score, TierToString(got), TierToString(tc.want))
+		}
+	}
+}
+
+func TestTierWeightsScore(t *testing.T) {
+	weights := TierWeights{
+		{Denom: "uatom", Weight: sdk.OneDec()},
+		{Denom: "uosmo", Weight: sdk.MustNewDecFromStr("0.12")},
+		{Denom: "wei", Weight: sdk.NewDec(1_000)},
+	}
+
+	tests := []struct {
+		name    string
+		weights TierWeights
+		amount  sdk.Coins
+		want    sdkmath.Int
+	}{
+		{"empty table counts the tier denom", nil, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("uosmo", 7)), sdkmath.NewInt(5)},
+		{"weighted sum", weights, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uosmo", 1_000_000)), sdkmath.NewInt(121_000)},
+		{"fractions are truncated after summing", weights, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 9)), sdkmath.NewInt(1)},
+		{"unweighted denoms don't count", weights, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1_000_000)), sdkmath.ZeroInt()},
+		{"overflowing weight saturates", weights, sdk.NewCoins(sdk.NewCoin("wei", maxTierScore)), maxTierScore},
+		{"overflowing sum saturates", weights, sdk.NewCoins(sdk.NewCoin("uatom", maxTierScore), sdk.NewCoin("uosmo", maxTierScore)), maxTierScore},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.weights.Score(tc.amount); !got.Equal(tc.want) {
+				t.Errorf("Score = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCalculateTierWhale(t *testing.T) {
+	f := setupKeeper(t)
+	params := f.k.GetParams(f.ctx)
+	params.TierWeights = TierWeights{
+		{Denom: "uatom", Weight: sdk.OneDec()},
+		{Denom: "wei", Weight: sdk.MustNewDecFromStr("0.000000000001")},
+	}
+	f.k.SetParams(f.ctx, params)
+
+	tests := []struct {
+		name   string
+		amount sdk.Coins
+		want   DonorTier
+	}{
+		{"just below bronze", uatom(9_999), TierNone},
+		{"bronze", uatom(10_000), TierBronze},
+		{"platinum", uatom(10_000_000), TierPlatinum},
+		// 1e19 wei is past int64 but weighs only 10 million uatom
+		{"large amount of another denom", sdk.NewCoins(sdk.NewCoin("wei", sdkmath.NewIntWithDecimal(1, 19))), TierPlatinum},
+		{"just below platinum in another denom", sdk.NewCoins(sdk.NewCoin("wei", sdkmath.NewIntWithDecimal(1, 19).SubRaw(1))), TierGold},
+		{"largest amount of every denom", sdk.NewCoins(sdk.NewCoin("uatom", maxTierScore), sdk.NewCoin("wei", maxTierScore)), TierPlatinum},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := f.k.CalculateTier(f.ctx, tc.amount); got != tc.want {
+				t.Errorf("CalculateTier(%s) = %s, want %s", tc.amount, TierToString(got), TierToString(tc.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package donation
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TierWeight converts one base unit of Denom into tier-denom units, e.g.
+// {Denom: "uosmo", Weight: 0.12} counts 1 uosmo as 0.12 uatom
+type TierWeight struct {
+	Denom  string
+	Weight sdk.Dec
+}
+
+// TierWeights is the per-denom weight table tiers are computed from
+type TierWeights []TierWeight
+
+// Validate rejects invalid, non-positive and duplicate weights. Weights
+// are keyed by base denom; accepted IBC vouchers use their base denom's.
+func (ws TierWeights) Validate() error {
+	seen := make(map[string]bool, len(ws))
+	for _, w := range ws {
+		if err := sdk.ValidateDenom(w.Denom); err != nil {
+			return fmt.Errorf("invalid tier weight denom: %w", err)
+		}
+		if isIBCDenom(w.Denom) {
+			return fmt.Errorf("tier weight for %s must use the base denom", w.Denom)
+		}
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return fmt.Errorf("tier weight for %s must be positive", w.Denom)
+		}
+		if seen[w.Denom] {
+			return fmt.Errorf("duplicate tier weight for %s", w.Denom)
+		}
+		seen[w.Denom] = true
+	}
+	return nil
+}
+
+// maxTierScore is the largest sdkmath.Int. Scores saturate at it, so
+// weighting and summing whale balances of several denoms can't overflow.
+var maxTierScore = sdkmath.NewIntFromBigInt(
+	new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), sdkmath.MaxBitLen), big.NewInt(1)),
+)
+
+// Score returns the weighted sum of amount in tier-denom units, truncated
+// and capped at maxTierScore. An empty table counts only the tier denom,
+// at weight 1; denoms without a weight do not count.
+func (ws TierWeights) Score(amount sdk.Coins) sdkmath.Int {
+	if len(ws) == 0 {
+		return amount.AmountOf(TierDenom)
+	}
+
+	// Sum the exact weighted amounts in LegacyDec fixed point with big.Int,
+	// which has no bit limit, and only convert back once capped
+	sum := new(big.Int)
+	for _, w := range ws {
+		sum.Add(sum, new(big.Int).Mul(w.Weight.BigInt(), amount.AmountOf(w.Denom).BigInt()))
+	}
+	sum.Quo(sum, sdkmath.LegacyOneDec().BigInt())
+
+	if sum.Cmp(maxTierScore.BigInt()) > 0 {
+		return maxTierScore
+	}
+	return sdkmath.NewIntFromBigInt(sum)
+}
+
+// tierScore is the amount tiers and leaderboards are computed from: the
+// weighted sum of coins, with accepted IBC vouchers counted by base denom
+func (k Keeper) tierScore(ctx sdk.Context, coins sdk.Coins) sdkmath.Int {
+	return k.GetParams(ctx).TierWeights.Score(k.tierCoins(ctx, coins))
+}
@@ -0,0 +1,117 @@
+package donation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// USDTierThresholds are the minimum lifetime USD totals for each tier. When
+// set, and the keeper has an oracle, tiers are computed from donors' USD
+// totals instead of the weighted native amount.
+type USDTierThresholds struct {
+	Bronze   sdk.Dec
+	Silver   sdk.Dec
+	Gold     sdk.Dec
+	Platinum sdk.Dec
+}
+
+// IsSet reports whether USD thresholds are configured
+func (t USDTierThresholds) IsSet() bool {
+	return !t.Bronze.IsNil() && t.Bronze.IsPositive()
+}
+
+// Validate checks that set thresholds are positive and strictly increasing
+func (t USDTierThresholds) Validate() error {
+	if t.Bronze.IsNil() || t.Bronze.IsZero() {
+		return nil
+	}
+
+	thresholds := []sdk.Dec{t.Bronze, t.Silver, t.Gold, t.Platinum}
+	for i, threshold := range thresholds {
+		if threshold.IsNil() || !threshold.IsPositive() {
+			return fmt.Errorf("usd tier thresholds must all be positive")
+		}
+		if i > 0 && !threshold.GT(thresholds[i-1]) {
+			return fmt.Errorf("usd tier thresholds must be increasing")
+		}
+	}
+	return nil
+}
+
+// Tier returns the tier reached by a lifetime USD total
+func (t USDTierThresholds) Tier(total sdk.Dec) DonorTier {
+	switch {
+	case total.GTE(t.Platinum):
+		return TierPlatinum
+	case total.GTE(t.Gold):
+		return TierGold
+	case total.GTE(t.Silver):
+		return TierSilver
+	case total.GTE(t.Bronze):
+		return TierBronze
+	default:
+		return TierNone
+	}
+}
+
+// Min returns the minimum USD total of a tier
+func (t USDTierThresholds) Min(tier DonorTier) sdk.Dec {
+	switch tier {
+	case TierPlatinum:
+		return t.Platinum
+	case TierGold:
+		return t.Gold
+	case TierSilver:
+		return t.Silver
+	case TierBronze:
+		return t.Bronze
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// usdValue converts amount to USD at the oracle's current prices. Accepted
+// IBC vouchers are priced by base denom; denoms without a price count as
+// zero. Without an oracle the value is zero.
+func (k Keeper) usdValue(ctx sdk.Context, amount sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	if k.oracleKeeper == nil {
+		return value
+	}
+
+	for _, coin := range k.tierCoins(ctx, amount) {
+		price, found := k.oracleKeeper.GetUSDPrice(ctx, coin.Denom)
+		if !found || price.IsNil() || !price.IsPositive() {
+			continue
+		}
+		value = value.Add(price.MulInt(coin.Amount))
+	}
+
+	return value
+}
+
+// donorTier computes a donor's tier from their contribution, decayed to the
+// current block: from the USD value when USD thresholds are set and an
+// oracle is wired, otherwise from the weighted native amount
+func (k Keeper) donorTier(ctx sdk.Context, donor DonorRecord) DonorTier {
+	coins, usd := k.effectiveContribution(ctx, donor)
+	thresholds := k.GetParams(ctx).USDTierThresholds
+	if k.oracleKeeper != nil && thresholds.IsSet() {
+		return thresholds.Tier(usd)
+	}
+	return k.CalculateTier(ctx, coins)
+}
+
+// addUSD adds delta to a USD total, treating an unset total as zero and
+// flooring at zero (refunds are valued at current prices)
+func addUSD(total, delta sdk.Dec) sdk.Dec {
+	if total.IsNil() {
+		total = sdk.ZeroDec()
+	}
+	total = total.Add(delta)
+	if total.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return total
+}
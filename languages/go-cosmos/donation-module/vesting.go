@@ -0,0 +1,248 @@
+package donation
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// VestingSchedule releases Total to a beneficiary linearly over Duration
+// seconds from Start, nothing before the cliff. The reserved funds leave the
+// withdrawable balance when the schedule is set.
+type VestingSchedule struct {
+	Beneficiary string
+	Total       sdk.Coins
+	Released    sdk.Coins
+	Start       int64 // unix seconds
+	Cliff       int64 // seconds after Start
+	Duration    int64 // seconds after Start, at least Cliff
+}
+
+// Validate performs basic validation of a vesting schedule
+func (v VestingSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Beneficiary); err != nil {
+		return fmt.Errorf("invalid vesting beneficiary: %w", err)
+	}
+	if !v.Total.IsValid() || v.Total.IsZero() {
+		return fmt.Errorf("vesting total must be positive")
+	}
+	if !v.Released.IsValid() || !v.Total.IsAllGTE(v.Released) {
+		return fmt.Errorf("vesting released %s exceeds total %s", v.Released, v.Total)
+	}
+	if v.Duration <= 0 || v.Cliff < 0 || v.Cliff > v.Duration {
+		return fmt.Errorf("vesting needs a positive duration and a cliff within it")
+	}
+	return nil
+}
+
+// Vested returns the part of Total unlocked at now, rounded down per denom
+func (v VestingSchedule) Vested(now int64) sdk.Coins {
+	elapsed := now - v.Start
+	if elapsed < v.Cliff {
+		return sdk.NewCoins()
+	}
+	if elapsed >= v.Duration {
+		return v.Total
+	}
+
+	vested, _ := sdk.NewDecCoinsFromCoins(v.Total...).MulDecTruncate(sdk.NewDec(elapsed).QuoInt64(v.Duration)).TruncateDecimal()
+	return vested
+}
+
+// Locked returns the part of Total not yet released
+func (v VestingSchedule) Locked() sdk.Coins {
+	return v.Total.Sub(v.Released...)
+}
+
+// GetVestingScheduleKey returns the store key of a beneficiary's schedule
+func GetVestingScheduleKey(beneficiary string) []byte {
+	return append(VestingScheduleKeyPrefix, address.MustLengthPrefix([]byte(beneficiary))...)
+}
+
+// GetVestingSchedule returns the vesting schedule of beneficiary
+func (k Keeper) GetVestingSchedule(ctx sdk.Context, beneficiary string) (VestingSchedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetVestingScheduleKey(beneficiary))
+	if bz == nil {
+		return VestingSchedule{}, false
+	}
+
+	var schedule VestingSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+func (k Keeper) setVestingSchedule(ctx sdk.Context, schedule VestingSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetVestingScheduleKey(schedule.Beneficiary), k.cdc.MustMarshal(&schedule))
+}
+
+// GetAllVestingSchedules returns every vesting schedule
+func (k Keeper) GetAllVestingSchedules(ctx sdk.Context) []VestingSchedule {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), VestingScheduleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	schedules := []VestingSchedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule VestingSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// SetVestingSchedule reserves total from the withdrawable balance for
+// beneficiary, released from now on over duration seconds after a cliff.
+// It replaces any existing schedule of the beneficiary, whose locked funds
+// return to the balance first; a zero total only cancels it. Only ADMIN
+// may set schedules.
+func (k Keeper) SetVestingSchedule(
+	ctx sdk.Context,
+	sender string,
+	beneficiary string,
+	total sdk.Coins,
+	cliff int64,
+	duration int64,
+) error {
+	state, found := k.GetState(ctx)
+	if !found || !state.Initialized {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if !k.HasRole(ctx, sender, RoleAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only ADMIN can set vesting schedules")
+	}
+
+	if existing, found := k.GetVestingSchedule(ctx, beneficiary); found {
+		state.TotalVesting = state.TotalVesting.Sub(existing.Locked()...)
+		ctx.KVStore(k.storeKey).Delete(GetVestingScheduleKey(beneficiary))
+	}
+
+	if total.IsZero() {
+		k.SetState(ctx, state)
+		if err := k.recordAudit(ctx, sender, AuditSetVestingSchedule, map[string]interface{}{
+			"beneficiary": beneficiary,
+			"total":       total.String(),
+		}); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(&EventVestingScheduleSet{
+			Sender:      sender,
+			Beneficiary: beneficiary,
+			Total:       total,
+		})
+	}
+
+	schedule := VestingSchedule{
+		Beneficiary: beneficiary,
+		Total:       total,
+		Released:    sdk.NewCoins(),
+		Start:       ctx.BlockTime().Unix(),
+		Cliff:       cliff,
+		Duration:    duration,
+	}
+	if err := schedule.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.checkStrictRecipient(ctx, beneficiary); err != nil {
+		return err
+	}
+
+	if !state.Withdrawable().IsAllGTE(total) {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "vesting total exceeds withdrawable balance")
+	}
+
+	state.TotalVesting = state.TotalVesting.Add(total...)
+	k.setVestingSchedule(ctx, schedule)
+	k.SetState(ctx, state)
+
+	if err := k.recordAudit(ctx, sender, AuditSetVestingSchedule, map[string]interface{}{
+		"beneficiary": beneficiary,
+		"total":       total.String(),
+		"cliff":       cliff,
+		"duration":    duration,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventVestingScheduleSet{
+		Sender:      sender,
+		Beneficiary: beneficiary,
+		Total:       total,
+		Start:       schedule.Start,
+		Cliff:       cliff,
+		Duration:    duration,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// releaseVesting pays out every schedule's newly unlocked tranche. Each
+// release is all-or-nothing; a failed one is retried next block.
+func (k Keeper) releaseVesting(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	for _, schedule := range k.GetAllVestingSchedules(ctx) {
+		tranche := schedule.Vested(now).Sub(schedule.Released...)
+		if tranche.IsZero() {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseTranche(cacheCtx, schedule, tranche); err != nil {
+			ctx.Logger().Error("failed to release vesting tranche", "beneficiary", schedule.Beneficiary, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) releaseTranche(ctx sdk.Context, schedule VestingSchedule, tranche sdk.Coins) error {
+	state, found := k.GetState(ctx)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not initialized")
+	}
+
+	if err := k.checkStrictRecipient(ctx, schedule.Beneficiary); err != nil {
+		return err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(schedule.Beneficiary)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, addr, tranche); err != nil {
+		return err
+	}
+
+	state.TotalVesting = state.TotalVesting.Sub(tranche...)
+	state.TotalWithdrawn = state.TotalWithdrawn.Add(tranche...)
+	k.SetState(ctx, state)
+
+	schedule.Released = schedule.Released.Add(tranche...)
+	if schedule.Locked().IsZero() {
+		ctx.KVStore(k.storeKey).Delete(GetVestingScheduleKey(schedule.Beneficiary))
+	} else {
+		k.setVestingSchedule(ctx, schedule)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&EventVestingReleased{
+		Beneficiary: schedule.Beneficiary,
+		Amount:      tranche,
+		Released:    schedule.Released,
+		Total:       schedule.Total,
+	}); err != nil {
+		return err
+	}
+
+	return k.afterWithdrawal(ctx, schedule.Beneficiary, tranche)
+}
@@ -0,0 +1,247 @@
+package donation
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// CosmWasm custom bindings let contracts on the same chain donate on behalf
+// of users and read donor tiers. Wire them into wasmd with:
+//
+//	wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+//		Custom: donation.CustomQuerier(app.DonationKeeper),
+//	})
+//	wasmkeeper.WithMessageHandlerDecorator(func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+//		return donation.NewWasmMessenger(old, app.DonationKeeper, app.txConfig.TxDecoder())
+//	})
+
+// WasmDonationMsg is the custom message a contract sends:
+//
+//	{"donate": {"donor": "cosmos1...", "amount": [{"denom": "uatom", "amount": "1000"}], "campaign_id": 1}}
+//
+// The coins are paid from the contract's balance and credited to donor,
+// which must be the contract itself or a signer of the transaction that
+// called it.
+type WasmDonationMsg struct {
+	Donate *struct {
+		Donor      string             `json:"donor"`
+		Amount     []wasmvmtypes.Coin `json:"amount"`
+		CampaignID uint64             `json:"campaign_id,omitempty"`
+		Memo       string             `json:"memo,omitempty"`
+		Anonymous  bool               `json:"anonymous,omitempty"`
+	} `json:"donate,omitempty"`
+}
+
+// WasmDonationQuery is the custom query a contract sends:
+//
+//	{"donor_tier": {"address": "cosmos1..."}}
+//	{"donation_state": {}}
+type WasmDonationQuery struct {
+	DonorTier *struct {
+		Address string `json:"address"`
+	} `json:"donor_tier,omitempty"`
+	DonationState *struct{} `json:"donation_state,omitempty"`
+}
+
+// DonorTierResponse answers a donor_tier query
+type DonorTierResponse struct {
+	Tier         uint8              `json:"tier"`
+	TierName     string             `json:"tier_name"`
+	TotalDonated []wasmvmtypes.Coin `json:"total_donated"`
+}
+
+// DonationStateResponse answers a donation_state query
+type DonationStateResponse struct {
+	TotalDonations []wasmvmtypes.Coin `json:"total_donations"`
+	DonorCount     uint64             `json:"donor_count"`
+	Paused         bool               `json:"paused"`
+	PauseReason    string             `json:"pause_reason,omitempty"`
+}
+
+// WasmMessenger matches wasmd's keeper.Messenger interface
+type WasmMessenger interface {
+	DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error)
+}
+
+// NewWasmMessenger wraps a wasmd messenger, handling donation custom
+// messages and passing everything else through. txDecoder reads the signers
+// of the calling transaction; without it contracts can only donate as
+// themselves.
+func NewWasmMessenger(wrapped WasmMessenger, k Keeper, txDecoder sdk.TxDecoder) WasmMessenger {
+	return &wasmMessenger{wrapped: wrapped, keeper: k, txDecoder: txDecoder}
+}
+
+type wasmMessenger struct {
+	wrapped   WasmMessenger
+	keeper    Keeper
+	txDecoder sdk.TxDecoder
+}
+
+// DispatchMsg implements WasmMessenger
+func (m *wasmMessenger) DispatchMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contractIBCPortID string,
+	msg wasmvmtypes.CosmosMsg,
+) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var custom WasmDonationMsg
+	if err := json.Unmarshal(msg.Custom, &custom); err != nil || custom.Donate == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	if err := m.keeper.checkCircuit(ctx, sdk.MsgTypeURL(&MsgDonate{})); err != nil {
+		return nil, nil, err
+	}
+
+	amount, err := wasmCoinsToSDK(custom.Donate.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	donor, err := sdk.AccAddressFromBech32(custom.Donate.Donor)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := m.checkDonor(ctx, contractAddr, donor); err != nil {
+		return nil, nil, err
+	}
+
+	// The contract pays, so a blocked contract can't donate through users
+	state, _ := m.keeper.GetState(ctx)
+	if reason, err := m.keeper.checkDonorLists(ctx, state, contractAddr.String()); err != nil {
+		return nil, nil, rejectDonation(reason, err)
+	}
+
+	// Collect the donation from the contract before crediting the donor
+	if err := m.keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddr, ModuleName, amount); err != nil {
+		return nil, nil, err
+	}
+
+	if custom.Donate.CampaignID != 0 {
+		err = m.keeper.DonateToCampaign(ctx, custom.Donate.Donor, custom.Donate.CampaignID, amount, custom.Donate.Memo, custom.Donate.Anonymous)
+	} else {
+		err = m.keeper.Donate(ctx, custom.Donate.Donor, amount, custom.Donate.Memo, custom.Donate.Anonymous)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
+
+// checkDonor admits the contract itself or a signer of the transaction that
+// called it as the donor, so a contract can't credit donations to (or push
+// tiers and rate limits onto) arbitrary addresses
+func (m *wasmMessenger) checkDonor(ctx sdk.Context, contractAddr, donor sdk.AccAddress) error {
+	if donor.Equals(contractAddr) {
+		return nil
+	}
+
+	for _, signer := range m.txSigners(ctx) {
+		if donor.Equals(signer) {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "contract %s can't donate on behalf of %s", contractAddr, donor)
+}
+
+// txSigners returns the signers of the transaction being executed, or none
+// if it can't be decoded
+func (m *wasmMessenger) txSigners(ctx sdk.Context) []sdk.AccAddress {
+	if m.txDecoder == nil || len(ctx.TxBytes()) == 0 {
+		return nil
+	}
+
+	tx, err := m.txDecoder(ctx.TxBytes())
+	if err != nil {
+		return nil
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+	return sigTx.GetSigners()
+}
+
+// CustomQuerier returns a wasmd custom query plugin for donation queries
+func CustomQuerier(k Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query WasmDonationQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		switch {
+		case query.DonorTier != nil:
+			donor, _ := k.GetDonor(ctx, query.DonorTier.Address)
+			donor = k.withEffectiveTier(ctx, donor)
+			return json.Marshal(DonorTierResponse{
+				Tier:         uint8(donor.Tier),
+				TierName:     tierName(donor.Tier),
+				TotalDonated: sdkCoinsToWasm(donor.TotalDonated),
+			})
+		case query.DonationState != nil:
+			state, _ := k.GetState(ctx)
+			return json.Marshal(DonationStateResponse{
+				TotalDonations: sdkCoinsToWasm(state.TotalDonations),
+				DonorCount:     state.DonorCount,
+				Paused:         state.Paused,
+				PauseReason:    state.PauseReason,
+			})
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown donation query")
+		}
+	}
+}
+
+// tierName returns the plain tier name, without the badge emoji
+func tierName(tier DonorTier) string {
+	switch tier {
+	case TierBronze:
+		return "Bronze"
+	case TierSilver:
+		return "Silver"
+	case TierGold:
+		return "Gold"
+	case TierPlatinum:
+		return "Platinum"
+	default:
+		return "None"
+	}
+}
+
+func wasmCoinsToSDK(coins []wasmvmtypes.Coin) (sdk.Coins, error) {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		amount, ok := sdk.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", coin.Amount)
+		}
+		c := sdk.Coin{Denom: coin.Denom, Amount: amount}
+		if err := c.Validate(); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		result = result.Add(c)
+	}
+
+	return result, nil
+}
+
+func sdkCoinsToWasm(coins sdk.Coins) []wasmvmtypes.Coin {
+	result := make([]wasmvmtypes.Coin, 0, len(coins))
+	for _, coin := range coins {
+		result = append(result, wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+	}
+	return result
+}
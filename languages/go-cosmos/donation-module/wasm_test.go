@@ -0,0 +1,76 @@
+package donation
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var testContract = sdk.AccAddress("contract____________")
+
+// signedTx is a transaction signed by signers
+type signedTx struct {
+	authsigning.SigVerifiableTx
+	signers []sdk.AccAddress
+}
+
+func (tx signedTx) GetSigners() []sdk.AccAddress {
+	return tx.signers
+}
+
+// wasmDonate builds a donate custom message for donor
+func wasmDonate(t *testing.T, donor sdk.AccAddress) wasmvmtypes.CosmosMsg {
+	t.Helper()
+
+	var msg WasmDonationMsg
+	msg.Donate = &struct {
+		Donor      string             `json:"donor"`
+		Amount     []wasmvmtypes.Coin `json:"amount"`
+		CampaignID uint64             `json:"campaign_id,omitempty"`
+		Memo       string             `json:"memo,omitempty"`
+		Anonymous  bool               `json:"anonymous,omitempty"`
+	}{Donor: donor.String(), Amount: sdkCoinsToWasm(uatom(1_000_000))}
+
+	custom, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return wasmvmtypes.CosmosMsg{Custom: custom}
+}
+
+func TestWasmDonateDonor(t *testing.T) {
+	f := setupKeeper(t)
+	f.ctx = f.ctx.WithTxBytes([]byte("tx"))
+	messenger := NewWasmMessenger(nil, f.k, func([]byte) (sdk.Tx, error) {
+		return signedTx{signers: []sdk.AccAddress{testDonor}}, nil
+	})
+
+	// Strangers can't be credited with the contract's donations
+	if _, _, err := messenger.DispatchMsg(f.ctx, testContract, "", wasmDonate(t, testRecipient)); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("donation for a non-signer: error = %v, want %v", err, sdkerrors.ErrUnauthorized)
+	}
+
+	for _, donor := range []sdk.AccAddress{testDonor, testContract} {
+		f.bank.EXPECT().SendCoinsFromAccountToModule(f.ctx, testContract, ModuleName, uatom(1_000_000)).Return(nil)
+		f.expectBadgeMint(donor)
+		if _, _, err := messenger.DispatchMsg(f.ctx, testContract, "", wasmDonate(t, donor)); err != nil {
+			t.Fatalf("donation for %s: %v", donor, err)
+		}
+		if _, found := f.k.GetDonor(f.ctx, donor.String()); !found {
+			t.Errorf("donor %s not credited", donor)
+		}
+	}
+
+	// A blocked contract can't donate, even for its caller
+	if err := f.k.UpdateDonorList(f.ctx, testAdmin.String(), DonorListBlock, []string{testContract.String()}, nil); err != nil {
+		t.Fatalf("UpdateDonorList: %v", err)
+	}
+	if _, _, err := messenger.DispatchMsg(f.ctx, testContract, "", wasmDonate(t, testDonor)); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("donation from a blocked contract: error = %v, want %v", err, sdkerrors.ErrUnauthorized)
+	}
+}
@@ -0,0 +1,259 @@
+// Package auditlog keeps a signed, hash-chained audit log of payout and
+// admin actions.
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/web3-showcase/rpc-tools/sigverify"
+)
+
+// auditGenesisHash is the PrevHash of the first record of a log
+var auditGenesisHash = strings.Repeat("0", 64)
+
+// AuditRecord is one entry of a hash-chained audit log. Hash covers every
+// other field, including PrevHash, so editing, dropping or reordering a
+// record breaks the chain; Signature is the operator's signature over Hash.
+type AuditRecord struct {
+	Seq       uint64          `json:"seq"`
+	Time      time.Time       `json:"time"`
+	Operator  string          `json:"operator"` // signer address
+	Action    string          `json:"action"`
+	Details   json.RawMessage `json:"details,omitempty"`
+	PrevHash  string          `json:"prev_hash"`
+	Hash      string          `json:"hash"`
+	Signature string          `json:"signature"`
+}
+
+// digest returns the hex sha256 of the record without Hash and Signature
+func (r AuditRecord) digest() (string, error) {
+	r.Hash, r.Signature = "", ""
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditLog appends signed, hash-chained records of payout and admin actions
+// to a JSON-lines file. The file is itself the tamper-evident bundle handed
+// to auditors, who check it with VerifyAuditLog.
+type AuditLog struct {
+	mu       sync.Mutex
+	path     string
+	sv       *sigverify.SignatureVerifier
+	key      string
+	operator string
+	seq      uint64
+	head     string
+}
+
+// NewAuditLog opens (or creates) the audit log at path, signing new records
+// with operatorKeyHex. An existing log is verified before it is extended;
+// its records must be signed by this operator or one of previousOperators,
+// the addresses of keys the log was signed with before a rotation.
+func NewAuditLog(path, operatorKeyHex string, previousOperators ...string) (*AuditLog, error) {
+	sv := sigverify.NewSignatureVerifier()
+	operator, err := sv.GetAddressFromPrivateKey(operatorKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator key: %w", err)
+	}
+
+	al := &AuditLog{
+		path:     path,
+		sv:       sv,
+		key:      operatorKeyHex,
+		operator: operator,
+		head:     auditGenesisHash,
+	}
+
+	file, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	if err == nil {
+		defer file.Close()
+		trusted := append([]string{operator}, previousOperators...)
+		last, err := VerifyAuditLog(file, trusted)
+		if err != nil {
+			return nil, fmt.Errorf("existing audit log is invalid: %w", err)
+		}
+		if last != nil {
+			al.seq, al.head = last.Seq, last.Hash
+		}
+	}
+
+	return al, nil
+}
+
+// Operator returns the address that signs new records
+func (al *AuditLog) Operator() string {
+	return al.operator
+}
+
+// Head returns the hash of the latest record, which auditors can pin to
+// detect truncation of a later export
+func (al *AuditLog) Head() string {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	return al.head
+}
+
+// Record appends a signed record of action; details is JSON-encoded
+func (al *AuditLog) Record(action string, details interface{}) (AuditRecord, error) {
+	var raw json.RawMessage
+	if details != nil {
+		data, err := json.Marshal(details)
+		if err != nil {
+			return AuditRecord{}, fmt.Errorf("failed to encode audit details: %w", err)
+		}
+		raw = data
+	}
+
+	al.mu.Lock()
+	defer al.mu.Unlock()
+
+	record := AuditRecord{
+		Seq:      al.seq + 1,
+		Time:     time.Now().UTC(),
+		Operator: al.operator,
+		Action:   action,
+		Details:  raw,
+		PrevHash: al.head,
+	}
+
+	hash, err := record.digest()
+	if err != nil {
+		return AuditRecord{}, err
+	}
+	record.Hash = hash
+
+	record.Signature, err = al.sv.SignMessage(hash, al.key)
+	if err != nil {
+		return AuditRecord{}, err
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return AuditRecord{}, err
+	}
+
+	file, err := os.OpenFile(al.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return AuditRecord{}, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return AuditRecord{}, fmt.Errorf("failed to write audit log: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return AuditRecord{}, fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	al.seq, al.head = record.Seq, record.Hash
+	return record, nil
+}
+
+// Export copies the log to w as a bundle for auditors
+func (al *AuditLog) Export(w io.Writer) error {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+
+	file, err := os.Open(al.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// VerifyAuditLog checks an exported audit bundle: sequence numbers, the
+// hash of every record, the chain of PrevHash links and each operator
+// signature. Every record must be signed by one of trustedOperators, the
+// operator addresses the auditor obtained out of band; otherwise anyone
+// able to rewrite the file could re-sign the whole chain with a fresh key.
+// It returns the last record, or nil for an empty log.
+func VerifyAuditLog(r io.Reader, trustedOperators []string) (*AuditRecord, error) {
+	if len(trustedOperators) == 0 {
+		return nil, errors.New("no trusted operators")
+	}
+	trusted := make(map[common.Address]bool, len(trustedOperators))
+	for _, operator := range trustedOperators {
+		if !common.IsHexAddress(operator) {
+			return nil, fmt.Errorf("invalid operator address %q", operator)
+		}
+		trusted[common.HexToAddress(operator)] = true
+	}
+
+	sv := sigverify.NewSignatureVerifier()
+	prev := auditGenesisHash
+	var last *AuditRecord
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var record AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		want := uint64(1)
+		if last != nil {
+			want = last.Seq + 1
+		}
+		if record.Seq != want {
+			return nil, fmt.Errorf("line %d: sequence %d, expected %d", line, record.Seq, want)
+		}
+		if record.PrevHash != prev {
+			return nil, fmt.Errorf("record %d: chain broken", record.Seq)
+		}
+
+		hash, err := record.digest()
+		if err != nil {
+			return nil, err
+		}
+		if hash != record.Hash {
+			return nil, fmt.Errorf("record %d: hash mismatch", record.Seq)
+		}
+
+		if !common.IsHexAddress(record.Operator) || !trusted[common.HexToAddress(record.Operator)] {
+			return nil, fmt.Errorf("record %d: operator %s is not trusted", record.Seq, record.Operator)
+		}
+
+		valid, err := sv.VerifySignature(record.Hash, record.Signature, record.Operator)
+		if err != nil || !valid {
+			return nil, fmt.Errorf("record %d: invalid operator signature", record.Seq)
+		}
+
+		prev = record.Hash
+		last = &record
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return last, nil
+}
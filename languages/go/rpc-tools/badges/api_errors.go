@@ -0,0 +1,179 @@
+package badges
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// problemBaseURI prefixes the type URI (relative, per RFC 7807) of every
+// problem this package serves
+const problemBaseURI = "/problems/"
+
+// APIError is an error with the HTTP status and problem details it should
+// be served as. Clients retry only problems marked Retryable.
+type APIError struct {
+	Status    int
+	Type      string // short slug, e.g. "not-found"
+	Title     string
+	Detail    string
+	Retryable bool
+	Err       error // underlying cause, not exposed to clients
+}
+
+// Error implements error
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Title, e.Err)
+	}
+	return e.Title + ": " + e.Detail
+}
+
+// Unwrap returns the underlying cause
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Problem is an RFC 7807 problem+json body with a retryable extension
+type Problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Retryable bool   `json:"retryable"`
+}
+
+// badRequest reports an invalid client request
+func badRequest(format string, args ...interface{}) *APIError {
+	return &APIError{
+		Status: http.StatusBadRequest,
+		Type:   "bad-request",
+		Title:  http.StatusText(http.StatusBadRequest),
+		Detail: fmt.Sprintf(format, args...),
+	}
+}
+
+// notFound reports a resource that does not exist
+func notFound(detail string) *APIError {
+	return &APIError{
+		Status: http.StatusNotFound,
+		Type:   "not-found",
+		Title:  http.StatusText(http.StatusNotFound),
+		Detail: detail,
+	}
+}
+
+// grpcStatus maps a gRPC status code, as returned in grpc-gateway error
+// bodies, to an HTTP status, problem type and whether a retry can succeed
+var grpcStatus = map[int]struct {
+	status    int
+	typ       string
+	retryable bool
+}{
+	1:  {http.StatusServiceUnavailable, "upstream-unavailable", true}, // CANCELLED
+	2:  {http.StatusInternalServerError, "unknown", false},            // UNKNOWN
+	3:  {http.StatusBadRequest, "bad-request", false},                 // INVALID_ARGUMENT
+	4:  {http.StatusGatewayTimeout, "upstream-timeout", true},         // DEADLINE_EXCEEDED
+	5:  {http.StatusNotFound, "not-found", false},                     // NOT_FOUND
+	6:  {http.StatusConflict, "conflict", false},                      // ALREADY_EXISTS
+	7:  {http.StatusForbidden, "forbidden", false},                    // PERMISSION_DENIED
+	8:  {http.StatusTooManyRequests, "rate-limited", true},            // RESOURCE_EXHAUSTED
+	9:  {http.StatusBadRequest, "failed-precondition", false},         // FAILED_PRECONDITION
+	10: {http.StatusConflict, "aborted", true},                        // ABORTED
+	11: {http.StatusBadRequest, "out-of-range", false},                // OUT_OF_RANGE
+	12: {http.StatusNotImplemented, "not-implemented", false},         // UNIMPLEMENTED
+	13: {http.StatusInternalServerError, "internal", false},           // INTERNAL
+	14: {http.StatusServiceUnavailable, "upstream-unavailable", true}, // UNAVAILABLE
+	15: {http.StatusInternalServerError, "data-loss", false},          // DATA_LOSS
+	16: {http.StatusUnauthorized, "unauthenticated", false},           // UNAUTHENTICATED
+}
+
+// upstreamError translates a non-200 response from a chain's REST (LCD)
+// endpoint. grpc-gateway bodies carry the gRPC code, which decides the
+// status; otherwise the upstream HTTP status does.
+func upstreamError(path string, resp *http.Response) *APIError {
+	var body struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	cause := fmt.Errorf("query %s: %s", path, resp.Status)
+
+	if json.Unmarshal(data, &body) == nil && body.Code != 0 {
+		if mapped, ok := grpcStatus[body.Code]; ok {
+			return &APIError{
+				Status:    mapped.status,
+				Type:      mapped.typ,
+				Title:     http.StatusText(mapped.status),
+				Detail:    body.Message,
+				Retryable: mapped.retryable,
+				Err:       cause,
+			}
+		}
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return &APIError{Status: http.StatusNotFound, Type: "not-found", Title: http.StatusText(http.StatusNotFound), Err: cause}
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return &APIError{Status: http.StatusTooManyRequests, Type: "rate-limited", Title: http.StatusText(http.StatusTooManyRequests), Retryable: true, Err: cause}
+	case resp.StatusCode >= 500:
+		return &APIError{Status: http.StatusBadGateway, Type: "upstream-error", Title: "Upstream error", Retryable: true, Err: cause}
+	default:
+		return &APIError{Status: http.StatusBadGateway, Type: "upstream-error", Title: "Upstream error", Err: cause}
+	}
+}
+
+// transportError translates a failure to reach an upstream at all
+func transportError(path string, err error) *APIError {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &APIError{
+			Status:    http.StatusGatewayTimeout,
+			Type:      "upstream-timeout",
+			Title:     "Upstream timeout",
+			Retryable: true,
+			Err:       fmt.Errorf("query %s: %w", path, err),
+		}
+	}
+	return &APIError{
+		Status:    http.StatusBadGateway,
+		Type:      "upstream-unavailable",
+		Title:     "Upstream unavailable",
+		Retryable: true,
+		Err:       fmt.Errorf("query %s: %w", path, err),
+	}
+}
+
+// ProblemFor returns the problem details to serve for err. Errors that are
+// not an *APIError are internal and their text is not exposed.
+func ProblemFor(err error) Problem {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return Problem{
+			Type:   problemBaseURI + "internal",
+			Title:  "Internal error",
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return Problem{
+		Type:      problemBaseURI + apiErr.Type,
+		Title:     apiErr.Title,
+		Status:    apiErr.Status,
+		Detail:    apiErr.Detail,
+		Retryable: apiErr.Retryable,
+	}
+}
+
+// writeProblem serves err as application/problem+json
+func writeProblem(w http.ResponseWriter, err error) {
+	problem := ProblemFor(err)
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
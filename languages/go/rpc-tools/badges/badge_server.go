@@ -0,0 +1,305 @@
+// Package badges serves embeddable SVG badges with live donation module
+// data, and RFC 7807 problem details for its errors.
+package badges
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/web3-showcase/rpc-tools/chainregistry"
+)
+
+// tierNames maps donation module tier numbers to badge labels
+var tierNames = map[string]string{
+	"0": "No Tier",
+	"1": "Bronze Donor",
+	"2": "Silver Donor",
+	"3": "Gold Donor",
+	"4": "Platinum Donor",
+}
+
+// tierColors maps badge labels to their value colour
+var tierColors = map[string]string{
+	"No Tier":        "#9f9f9f",
+	"Bronze Donor":   "#cd7f32",
+	"Silver Donor":   "#a8a9ad",
+	"Gold Donor":     "#dfb317",
+	"Platinum Donor": "#5b8fb9",
+}
+
+// lcdCoin is a coin as returned by the Cosmos REST (LCD) API
+type lcdCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// BadgeServer renders embeddable SVG badges from live donation module data
+// read through a chain's REST (LCD) endpoint, e.g.
+//
+//	GET /badge/campaign/7      -> "raised | 4,200 of 10,000 ATOM"
+//	GET /badge/donor/cosmos1.. -> "donor  | Gold Donor"
+type BadgeServer struct {
+	lcdURL   string
+	chain    string
+	registry *chainregistry.ChainRegistry // optional, used for symbols and decimals
+	client   *http.Client
+	timeout  time.Duration
+	maxAge   time.Duration
+}
+
+// NewBadgeServer creates a badge server reading from lcdURL. If registry is
+// non-nil, amounts are shown in display units of chain's assets.
+func NewBadgeServer(lcdURL, chain string, registry *chainregistry.ChainRegistry) *BadgeServer {
+	return &BadgeServer{
+		lcdURL:   strings.TrimSuffix(lcdURL, "/"),
+		chain:    chain,
+		registry: registry,
+		client:   &http.Client{},
+		timeout:  chainregistry.DefaultRequestTimeout,
+		maxAge:   5 * time.Minute,
+	}
+}
+
+// SetTimeout sets the per-request timeout for LCD queries
+func (s *BadgeServer) SetTimeout(timeout time.Duration) {
+	s.timeout = timeout
+}
+
+// SetMaxAge sets how long clients and CDNs may cache a rendered badge
+func (s *BadgeServer) SetMaxAge(maxAge time.Duration) {
+	s.maxAge = maxAge
+}
+
+// ServeHTTP implements http.Handler
+func (s *BadgeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeProblem(w, &APIError{
+			Status: http.StatusMethodNotAllowed,
+			Type:   "method-not-allowed",
+			Title:  http.StatusText(http.StatusMethodNotAllowed),
+			Detail: r.Method + " is not supported",
+		})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "badge" {
+		writeProblem(w, notFound("no badge at "+r.URL.Path))
+		return
+	}
+
+	var label, value, color string
+	var err error
+	switch parts[1] {
+	case "campaign":
+		label = "raised"
+		color = "#4c1"
+		value, err = s.campaignProgress(r.Context(), parts[2])
+	case "donor":
+		label = "donor"
+		value, err = s.donorTier(r.Context(), parts[2])
+		color = tierColors[value]
+	default:
+		writeProblem(w, notFound("unknown badge kind "+parts[1]))
+		return
+	}
+	if err != nil {
+		writeProblem(w, err)
+		return
+	}
+
+	svg := renderBadge(label, value, color)
+	sum := sha256.Sum256([]byte(svg))
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+
+	w.Header().Set("Content-Type", "image/svg+xml; charset=utf-8")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.maxAge.Seconds())))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	_, _ = w.Write([]byte(svg))
+}
+
+func (s *BadgeServer) campaignProgress(ctx context.Context, id string) (string, error) {
+	campaignID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return "", badRequest("invalid campaign id %q", id)
+	}
+
+	var res struct {
+		Campaign struct {
+			Goal   []lcdCoin `json:"goal"`
+			Raised []lcdCoin `json:"raised"`
+		} `json:"campaign"`
+	}
+	if err := s.query(ctx, "/donation/v1/campaigns/"+strconv.FormatUint(campaignID, 10), &res); err != nil {
+		return "", err
+	}
+
+	goal := res.Campaign.Goal
+	if len(goal) == 0 {
+		// Open-ended campaign: show what has been raised so far
+		if len(res.Campaign.Raised) == 0 {
+			return "0", nil
+		}
+		coin := res.Campaign.Raised[0]
+		amount, symbol := s.display(ctx, coin.Denom, coin.Amount)
+		return amount + " " + symbol, nil
+	}
+
+	// Progress is shown in the goal's first denom
+	denom := goal[0].Denom
+	raised := "0"
+	for _, coin := range res.Campaign.Raised {
+		if coin.Denom == denom {
+			raised = coin.Amount
+		}
+	}
+
+	raisedAmount, symbol := s.display(ctx, denom, raised)
+	goalAmount, _ := s.display(ctx, denom, goal[0].Amount)
+	return fmt.Sprintf("%s of %s %s", raisedAmount, goalAmount, symbol), nil
+}
+
+func (s *BadgeServer) donorTier(ctx context.Context, address string) (string, error) {
+	var res struct {
+		Donor struct {
+			Tier json.RawMessage `json:"tier"`
+		} `json:"donor"`
+	}
+	if err := s.query(ctx, "/donation/v1/donors/"+url.PathEscape(address), &res); err != nil {
+		return "", err
+	}
+
+	// Proto JSON may render the tier as a number or a quoted number
+	tier := strings.Trim(string(res.Donor.Tier), `"`)
+	name, ok := tierNames[tier]
+	if !ok {
+		return "", &APIError{
+			Status: http.StatusBadGateway,
+			Type:   "upstream-error",
+			Title:  "Upstream error",
+			Err:    fmt.Errorf("unknown tier %s", tier),
+		}
+	}
+
+	return name, nil
+}
+
+// display converts a base-unit amount to display units with thousands
+// separators, falling back to the base denom if it cannot be resolved
+func (s *BadgeServer) display(ctx context.Context, denom, amount string) (string, string) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return amount, denom
+	}
+
+	symbol := denom
+	if s.registry != nil {
+		if meta, err := s.registry.ResolveDenom(ctx, s.chain, denom); err == nil {
+			symbol = meta.Symbol
+			value.Quo(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(meta.Decimals)), nil))
+		}
+	}
+
+	return groupThousands(value.String()), symbol
+}
+
+func (s *BadgeServer) query(ctx context.Context, path string, out interface{}) error {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.lcdURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request for %s: %w", path, err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return transportError(path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return upstreamError(path, resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return &APIError{
+			Status: http.StatusBadGateway,
+			Type:   "upstream-error",
+			Title:  "Upstream error",
+			Err:    fmt.Errorf("decode %s: %w", path, err),
+		}
+	}
+	return nil
+}
+
+// renderBadge draws a flat two-part badge in the common shields.io layout
+func renderBadge(label, value, color string) string {
+	if color == "" {
+		color = "#9f9f9f"
+	}
+
+	// Approximate Verdana 11px glyph width; good enough for short labels
+	labelWidth := 10 + 7*len(label)
+	valueWidth := 10 + 7*len(value)
+	width := labelWidth + valueWidth
+
+	label = html.EscapeString(label)
+	value = html.EscapeString(value)
+
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s: %s">`+
+		`<title>%s: %s</title>`+
+		`<rect width="%d" height="20" fill="#555"/>`+
+		`<rect x="%d" width="%d" height="20" fill="%s"/>`+
+		`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`+
+		`<text x="%d" y="14">%s</text>`+
+		`<text x="%d" y="14">%s</text>`+
+		`</g></svg>`,
+		width, label, value,
+		label, value,
+		labelWidth,
+		labelWidth, valueWidth, color,
+		labelWidth/2, label,
+		labelWidth+valueWidth/2, value,
+	)
+}
+
+// groupThousands inserts commas into a non-negative decimal integer string
+func groupThousands(s string) string {
+	if len(s) <= 3 {
+		return s
+	}
+
+	var b strings.Builder
+	head := len(s) % 3
+	if head > 0 {
+		b.WriteString(s[:head])
+	}
+	for i := head; i < len(s); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(s[i : i+3])
+	}
+
+	return b.String()
+}
@@ -0,0 +1,131 @@
+package badges
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeLCD serves the donation module's REST routes the way the gateway does
+type fakeLCD struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	paths []string // escaped request paths
+}
+
+func newFakeLCD(t *testing.T) *fakeLCD {
+	t.Helper()
+	lcd := &fakeLCD{}
+	lcd.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		lcd.mu.Lock()
+		lcd.paths = append(lcd.paths, req.URL.EscapedPath())
+		lcd.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/donation/v1/campaigns/7":
+			_, _ = w.Write([]byte(`{"campaign":{"id":"7","goal":[{"denom":"uatom","amount":"10000"}],"raised":[{"denom":"uatom","amount":"4200"}]}}`))
+		case "/donation/v1/campaigns/8":
+			_, _ = w.Write([]byte(`{"campaign":{"id":"8","goal":[],"raised":[{"denom":"uatom","amount":"1500"}]}}`))
+		case "/donation/v1/donors/cosmos1gold":
+			_, _ = w.Write([]byte(`{"donor":{"address":"cosmos1gold","tier":3}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":5,"message":"not found","details":[]}`))
+		}
+	}))
+	t.Cleanup(lcd.Close)
+	return lcd
+}
+
+func (l *fakeLCD) lastPath() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.paths) == 0 {
+		return ""
+	}
+	return l.paths[len(l.paths)-1]
+}
+
+func serve(s *BadgeServer, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func TestBadgeServer(t *testing.T) {
+	lcd := newFakeLCD(t)
+	s := NewBadgeServer(lcd.URL, "cosmoshub", nil)
+
+	tests := []struct {
+		target  string
+		lcdPath string
+		value   string
+	}{
+		{"/badge/campaign/7", "/donation/v1/campaigns/7", "4,200 of 10,000 uatom"},
+		{"/badge/campaign/8", "/donation/v1/campaigns/8", "1,500 uatom"},
+		{"/badge/donor/cosmos1gold", "/donation/v1/donors/cosmos1gold", "Gold Donor"},
+	}
+
+	for _, tt := range tests {
+		rec := serve(s, tt.target)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s: status %d: %s", tt.target, rec.Code, rec.Body)
+		}
+		if got := lcd.lastPath(); got != tt.lcdPath {
+			t.Errorf("GET %s queried %s, want %s", tt.target, got, tt.lcdPath)
+		}
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "image/svg+xml") {
+			t.Errorf("GET %s: Content-Type %s", tt.target, ct)
+		}
+		if !strings.Contains(rec.Body.String(), "<title>") || !strings.Contains(rec.Body.String(), tt.value) {
+			t.Errorf("GET %s: badge does not show %q: %s", tt.target, tt.value, rec.Body)
+		}
+	}
+}
+
+func TestBadgeServerErrors(t *testing.T) {
+	lcd := newFakeLCD(t)
+	s := NewBadgeServer(lcd.URL, "cosmoshub", nil)
+
+	tests := []struct {
+		target  string
+		status  int
+		lcdPath string // "" if the LCD must not be queried
+	}{
+		{"/badge/campaign/99", http.StatusNotFound, "/donation/v1/campaigns/99"},
+		{"/badge/campaign/abc", http.StatusBadRequest, ""},
+		{"/badge/donor/cosmos1unknown", http.StatusNotFound, "/donation/v1/donors/cosmos1unknown"},
+		// The address is escaped, so it cannot add a query or path to the LCD request
+		{"/badge/donor/cosmos1x%3Fpagination.limit=1", http.StatusNotFound, "/donation/v1/donors/cosmos1x%3Fpagination.limit=1"},
+		{"/badge/team/1", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		before := lcd.lastPath()
+		rec := serve(s, tt.target)
+		if rec.Code != tt.status {
+			t.Errorf("GET %s: status %d, want %d", tt.target, rec.Code, tt.status)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+			t.Errorf("GET %s: Content-Type %s", tt.target, ct)
+		}
+
+		var problem Problem
+		if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil || problem.Status != tt.status {
+			t.Errorf("GET %s: problem %+v, %v", tt.target, problem, err)
+		}
+
+		if tt.lcdPath != "" {
+			if got := lcd.lastPath(); got != tt.lcdPath {
+				t.Errorf("GET %s queried %s, want %s", tt.target, got, tt.lcdPath)
+			}
+		} else if got := lcd.lastPath(); got != before {
+			t.Errorf("GET %s queried the LCD at %s", tt.target, got)
+		}
+	}
+}
@@ -0,0 +1,365 @@
+// Package chainregistry resolves chain and denom metadata from the Cosmos
+// chain registry.
+package chainregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultChainRegistryURL serves the cosmos/chain-registry repository
+const DefaultChainRegistryURL = "https://raw.githubusercontent.com/cosmos/chain-registry/master"
+
+// DefaultRequestTimeout bounds each outbound request unless overridden
+const DefaultRequestTimeout = 10 * time.Second
+
+// DefaultCacheTTL is how long a cached registry document is used before it
+// is fetched again
+const DefaultCacheTTL = 24 * time.Hour
+
+// refreshRetryInterval spaces background refreshes of an expired document
+// while the registry is unreachable
+const refreshRetryInterval = time.Minute
+
+// ChainInfo is the subset of a chain-registry chain.json we use
+type ChainInfo struct {
+	ChainName    string `json:"chain_name"`
+	ChainID      string `json:"chain_id"`
+	Bech32Prefix string `json:"bech32_prefix"`
+	APIs         struct {
+		RPC  []ChainEndpoint `json:"rpc"`
+		REST []ChainEndpoint `json:"rest"`
+		GRPC []ChainEndpoint `json:"grpc"`
+	} `json:"apis"`
+}
+
+// ChainEndpoint is a public endpoint listed in the chain registry
+type ChainEndpoint struct {
+	Address  string `json:"address"`
+	Provider string `json:"provider"`
+}
+
+// AssetList is a chain-registry assetlist.json
+type AssetList struct {
+	ChainName string  `json:"chain_name"`
+	Assets    []Asset `json:"assets"`
+}
+
+// Asset is a single asset entry in an asset list
+type Asset struct {
+	Base       string      `json:"base"`
+	Display    string      `json:"display"`
+	Symbol     string      `json:"symbol"`
+	DenomUnits []DenomUnit `json:"denom_units"`
+}
+
+// DenomUnit maps a denom to its exponent relative to the base denom
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
+
+// DenomMetadata is the resolved display information for a base denom
+type DenomMetadata struct {
+	ChainName string
+	Base      string
+	Display   string
+	Symbol    string
+	Decimals  uint32
+}
+
+// ChainRegistry resolves chain and denom data from the chain registry,
+// caching every document in memory and in a local key-value directory so
+// services keep working when the registry is unreachable. Cached documents
+// expire after the cache TTL, measured from the cache file's mtime.
+type ChainRegistry struct {
+	baseURL  string
+	cacheDir string
+	client   *http.Client
+	timeout  time.Duration
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu         sync.RWMutex
+	docs       map[string]*cachedDoc // "chain/file" -> parsed document
+	refreshing map[string]bool
+}
+
+// cachedDoc is a parsed registry document, when it was fetched and when a
+// refresh was last attempted
+type cachedDoc struct {
+	value     interface{}
+	fetched   time.Time
+	attempted time.Time
+}
+
+// NewChainRegistry creates a registry reading from baseURL and caching under cacheDir.
+// An empty cacheDir disables the on-disk cache.
+func NewChainRegistry(baseURL, cacheDir string) *ChainRegistry {
+	if baseURL == "" {
+		baseURL = DefaultChainRegistryURL
+	}
+
+	return &ChainRegistry{
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		cacheDir: cacheDir,
+		client:   &http.Client{},
+		timeout:  DefaultRequestTimeout,
+		ttl:      DefaultCacheTTL,
+		now:      time.Now,
+		docs:     make(map[string]*cachedDoc),
+
+		refreshing: make(map[string]bool),
+	}
+}
+
+// SetTimeout sets the per-request timeout; zero leaves requests bounded
+// only by the caller's context
+func (r *ChainRegistry) SetTimeout(timeout time.Duration) {
+	r.timeout = timeout
+}
+
+// SetCacheTTL sets how long cached documents are used before they are
+// fetched again; zero keeps them forever
+func (r *ChainRegistry) SetCacheTTL(ttl time.Duration) {
+	r.ttl = ttl
+}
+
+// Chain returns the chain.json for a registry chain name
+func (r *ChainRegistry) Chain(ctx context.Context, chainName string) (*ChainInfo, error) {
+	doc, err := r.get(ctx, chainName, "chain.json", func() interface{} { return &ChainInfo{} })
+	if err != nil {
+		return nil, err
+	}
+	return doc.(*ChainInfo), nil
+}
+
+// Assets returns the assetlist.json for a registry chain name
+func (r *ChainRegistry) Assets(ctx context.Context, chainName string) (*AssetList, error) {
+	doc, err := r.get(ctx, chainName, "assetlist.json", func() interface{} { return &AssetList{} })
+	if err != nil {
+		return nil, err
+	}
+	return doc.(*AssetList), nil
+}
+
+// get returns a registry document from memory, loading it on first use. An
+// expired document is still returned while it is refreshed in the
+// background, so callers never wait on the registry once a chain is known.
+func (r *ChainRegistry) get(ctx context.Context, chainName, file string, newDoc func() interface{}) (interface{}, error) {
+	key := chainName + "/" + file
+
+	r.mu.RLock()
+	doc, ok := r.docs[key]
+	r.mu.RUnlock()
+	if ok {
+		if r.expired(doc.fetched) && r.now().Sub(doc.attempted) >= refreshRetryInterval {
+			r.refresh(chainName, file, newDoc)
+		}
+		return doc.value, nil
+	}
+
+	value := newDoc()
+	fetched, err := r.load(ctx, chainName, file, value)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.docs[key] = &cachedDoc{value: value, fetched: fetched, attempted: r.now()}
+	r.mu.Unlock()
+
+	return value, nil
+}
+
+// refresh reloads an expired document in the background, at most once at a time
+func (r *ChainRegistry) refresh(chainName, file string, newDoc func() interface{}) {
+	key := chainName + "/" + file
+
+	r.mu.Lock()
+	if r.refreshing[key] {
+		r.mu.Unlock()
+		return
+	}
+	r.refreshing[key] = true
+	r.mu.Unlock()
+
+	go func() {
+		value := newDoc()
+		fetched, err := r.load(context.Background(), chainName, file, value)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.refreshing, key)
+		// On failure the stale document stays in use until the next attempt
+		if err == nil {
+			r.docs[key] = &cachedDoc{value: value, fetched: fetched, attempted: r.now()}
+		} else if doc, ok := r.docs[key]; ok {
+			r.docs[key] = &cachedDoc{value: doc.value, fetched: doc.fetched, attempted: r.now()}
+		}
+	}()
+}
+
+func (r *ChainRegistry) expired(fetched time.Time) bool {
+	return r.ttl > 0 && r.now().Sub(fetched) >= r.ttl
+}
+
+// ResolveDenom returns symbol and decimals for a base denom on a chain
+func (r *ChainRegistry) ResolveDenom(ctx context.Context, chainName, base string) (DenomMetadata, error) {
+	assets, err := r.Assets(ctx, chainName)
+	if err != nil {
+		return DenomMetadata{}, err
+	}
+
+	for _, asset := range assets.Assets {
+		if asset.Base != base {
+			continue
+		}
+
+		meta := DenomMetadata{
+			ChainName: chainName,
+			Base:      asset.Base,
+			Display:   asset.Display,
+			Symbol:    asset.Symbol,
+		}
+		for _, unit := range asset.DenomUnits {
+			if unit.Denom == asset.Display {
+				meta.Decimals = unit.Exponent
+			}
+		}
+		return meta, nil
+	}
+
+	return DenomMetadata{}, fmt.Errorf("denom %s not found on %s", base, chainName)
+}
+
+// Bech32Prefix returns the account address prefix of a chain
+func (r *ChainRegistry) Bech32Prefix(ctx context.Context, chainName string) (string, error) {
+	chain, err := r.Chain(ctx, chainName)
+	if err != nil {
+		return "", err
+	}
+
+	if chain.Bech32Prefix == "" {
+		return "", fmt.Errorf("no bech32 prefix registered for %s", chainName)
+	}
+
+	return chain.Bech32Prefix, nil
+}
+
+// RPCEndpoints returns the registered RPC endpoint addresses of a chain
+func (r *ChainRegistry) RPCEndpoints(ctx context.Context, chainName string) ([]string, error) {
+	chain, err := r.Chain(ctx, chainName)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]string, 0, len(chain.APIs.RPC))
+	for _, ep := range chain.APIs.RPC {
+		endpoints = append(endpoints, ep.Address)
+	}
+
+	return endpoints, nil
+}
+
+// load reads a registry document, preferring an unexpired on-disk cache
+// and otherwise fetching it from the remote registry and refreshing the
+// cache. If the registry cannot be reached an expired cache is still used.
+// It returns when the document was fetched.
+func (r *ChainRegistry) load(ctx context.Context, chainName, file string, out interface{}) (time.Time, error) {
+	if strings.ContainsAny(chainName, `/\`) || chainName == ".." {
+		return time.Time{}, fmt.Errorf("invalid chain name %q", chainName)
+	}
+
+	cached, modTime, cacheErr := r.readCache(chainName, file)
+	if cacheErr == nil && !r.expired(modTime) {
+		if err := json.Unmarshal(cached, out); err == nil {
+			return modTime, nil
+		}
+	}
+
+	data, err := r.fetch(ctx, chainName+"/"+file)
+	if err != nil {
+		if cacheErr == nil && json.Unmarshal(cached, out) == nil {
+			return modTime, nil
+		}
+		return time.Time{}, err
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse %s/%s: %w", chainName, file, err)
+	}
+
+	// A failed cache write only costs a refetch next time
+	_ = r.writeCache(chainName, file, data)
+
+	return r.now(), nil
+}
+
+func (r *ChainRegistry) fetch(ctx context.Context, path string) ([]byte, error) {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/"+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request for %s: %w", path, err)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", path, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// readCache returns a cached document and its mtime, the time it was fetched
+func (r *ChainRegistry) readCache(chainName, file string) ([]byte, time.Time, error) {
+	if r.cacheDir == "" {
+		return nil, time.Time{}, errors.New("cache disabled")
+	}
+
+	path := filepath.Join(r.cacheDir, chainName, file)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return data, info.ModTime(), nil
+}
+
+func (r *ChainRegistry) writeCache(chainName, file string, data []byte) error {
+	if r.cacheDir == "" {
+		return nil
+	}
+
+	dir := filepath.Join(r.cacheDir, chainName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, file), data, 0o644)
+}
@@ -0,0 +1,150 @@
+package chainregistry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRegistry serves chain.json with the current prefix and counts fetches
+type fakeRegistry struct {
+	*httptest.Server
+	fetches atomic.Int32
+	down    atomic.Bool
+
+	mu     sync.Mutex
+	prefix string
+}
+
+func newFakeRegistry(t *testing.T, prefix string) *fakeRegistry {
+	t.Helper()
+	f := &fakeRegistry{prefix: prefix}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if f.down.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		f.fetches.Add(1)
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		fmt.Fprintf(w, `{"chain_name":"cosmoshub","bech32_prefix":%q}`, f.prefix)
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeRegistry) setPrefix(prefix string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.prefix = prefix
+}
+
+// clock is a settable time source for the registry
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestRegistry(baseURL, cacheDir string, c *clock) *ChainRegistry {
+	r := NewChainRegistry(baseURL, cacheDir)
+	r.now = c.Now
+	return r
+}
+
+func prefix(t *testing.T, r *ChainRegistry) string {
+	t.Helper()
+	p, err := r.Bech32Prefix(context.Background(), "cosmoshub")
+	if err != nil {
+		t.Fatalf("Bech32Prefix: %v", err)
+	}
+	return p
+}
+
+func TestDiskCacheTTL(t *testing.T) {
+	registry := newFakeRegistry(t, "cosmos")
+	cacheDir := t.TempDir()
+	c := &clock{now: time.Now()}
+
+	if p := prefix(t, newTestRegistry(registry.URL, cacheDir, c)); p != "cosmos" {
+		t.Fatalf("prefix = %q", p)
+	}
+
+	// A fresh cache file is used without asking the registry
+	registry.setPrefix("atom")
+	if p := prefix(t, newTestRegistry(registry.URL, cacheDir, c)); p != "cosmos" || registry.fetches.Load() != 1 {
+		t.Fatalf("fresh cache: prefix = %q after %d fetches", p, registry.fetches.Load())
+	}
+
+	// Once the file's mtime is older than the TTL it is fetched again
+	cacheFile := filepath.Join(cacheDir, "cosmoshub", "chain.json")
+	old := c.Now().Add(-DefaultCacheTTL - time.Minute)
+	if err := os.Chtimes(cacheFile, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if p := prefix(t, newTestRegistry(registry.URL, cacheDir, c)); p != "atom" || registry.fetches.Load() != 2 {
+		t.Fatalf("expired cache: prefix = %q after %d fetches", p, registry.fetches.Load())
+	}
+
+	// An expired cache is still used while the registry is unreachable
+	if err := os.Chtimes(cacheFile, old, old); err != nil {
+		t.Fatal(err)
+	}
+	registry.down.Store(true)
+	if p := prefix(t, newTestRegistry(registry.URL, cacheDir, c)); p != "atom" {
+		t.Fatalf("registry down: prefix = %q", p)
+	}
+}
+
+func TestBackgroundRefresh(t *testing.T) {
+	registry := newFakeRegistry(t, "cosmos")
+	c := &clock{now: time.Now()}
+	r := newTestRegistry(registry.URL, t.TempDir(), c)
+	r.SetCacheTTL(time.Hour)
+
+	if p := prefix(t, r); p != "cosmos" {
+		t.Fatalf("prefix = %q", p)
+	}
+
+	// Within the TTL the in-memory document is served as is
+	registry.setPrefix("atom")
+	c.Advance(30 * time.Minute)
+	if p := prefix(t, r); p != "cosmos" || registry.fetches.Load() != 1 {
+		t.Fatalf("fresh: prefix = %q after %d fetches", p, registry.fetches.Load())
+	}
+
+	// Past the TTL the stale document is served while it is refreshed
+	c.Advance(time.Hour)
+	if p := prefix(t, r); p != "cosmos" {
+		t.Fatalf("expired: prefix = %q, want the stale value", p)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for prefix(t, r) != "atom" {
+		if time.Now().After(deadline) {
+			t.Fatalf("document not refreshed after %d fetches", registry.fetches.Load())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := registry.fetches.Load(); n != 2 {
+		t.Errorf("refresh took %d fetches, want 1", n-1)
+	}
+}
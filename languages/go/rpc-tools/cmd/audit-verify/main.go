@@ -0,0 +1,40 @@
+// Command audit-verify checks an exported audit log bundle against the
+// trusted operator addresses and prints its head hash.
+//
+//	audit-verify -operators 0xOperator[,0xPreviousOperator] audit.jsonl
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/web3-showcase/rpc-tools/auditlog"
+)
+
+func main() {
+	operators := flag.String("operators", "", "comma-separated trusted operator addresses")
+	flag.Parse()
+	if flag.NArg() != 1 || *operators == "" {
+		fmt.Fprintln(os.Stderr, "usage: audit-verify -operators 0x...[,0x...] <bundle.jsonl>")
+		os.Exit(2)
+	}
+
+	file, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	last, err := auditlog.VerifyAuditLog(file, strings.Split(*operators, ","))
+	if err != nil {
+		log.Fatalf("audit log does not verify: %v", err)
+	}
+	if last == nil {
+		fmt.Println("empty audit log")
+		return
+	}
+	fmt.Printf("verified %d records, head %s\n", last.Seq, last.Hash)
+}
@@ -0,0 +1,34 @@
+// Command badge-server serves donation badges read from a chain's REST
+// (LCD) endpoint.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/web3-showcase/rpc-tools/badges"
+	"github.com/web3-showcase/rpc-tools/chainregistry"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	lcdURL := flag.String("lcd", "http://localhost:1317", "chain REST (LCD) endpoint")
+	chain := flag.String("chain", "", "chain-registry name used for denom symbols and decimals, e.g. cosmoshub")
+	cacheDir := flag.String("registry-cache", ".cache/chain-registry", "chain registry cache directory")
+	maxAge := flag.Duration("max-age", 5*time.Minute, "Cache-Control max-age of rendered badges")
+	flag.Parse()
+
+	var registry *chainregistry.ChainRegistry
+	if *chain != "" {
+		registry = chainregistry.NewChainRegistry("", *cacheDir)
+	}
+
+	server := badges.NewBadgeServer(*lcdURL, *chain, registry)
+	server.SetMaxAge(*maxAge)
+
+	http.Handle("/badge/", server)
+	log.Printf("serving badges from %s on %s", *lcdURL, *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
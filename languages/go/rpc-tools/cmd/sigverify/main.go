@@ -0,0 +1,51 @@
+// Command sigverify demonstrates the sigverify package: it generates a key
+// pair, signs a message, verifies the signature and hashes the message.
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/web3-showcase/rpc-tools/sigverify"
+)
+
+func main() {
+	verifier := sigverify.NewSignatureVerifier()
+
+	fmt.Println("🔐 Ethereum Signature Verifier")
+	fmt.Println()
+
+	// Generate new key pair
+	fmt.Println("=== Generating New Key Pair ===")
+	privateKey, address, err := verifier.GeneratePrivateKey()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Private Key: %s\n", privateKey)
+	fmt.Printf("Address: %s\n", address)
+
+	// Sign a message
+	fmt.Println("\n=== Signing Message ===")
+	message := "Hello, Ethereum!"
+	signature, err := verifier.SignMessage(message, privateKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Message: %s\n", message)
+	fmt.Printf("Signature: %s\n", signature)
+
+	// Verify signature
+	fmt.Println("\n=== Verifying Signature ===")
+	isValid, err := verifier.VerifySignature(message, signature, address)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Signature Valid: %v\n", isValid)
+
+	// Hash message
+	fmt.Println("\n=== Hashing Message ===")
+	hash := verifier.HashMessage(message)
+	fmt.Printf("Keccak256 Hash: %s\n", hash)
+
+	fmt.Println("\n✅ All operations completed successfully!")
+}
@@ -0,0 +1,240 @@
+// Package eas issues donation receipts as Ethereum Attestation Service
+// attestations.
+package eas
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// DonationSchema is the EAS schema donation receipts are attested under.
+// Register it once with the chain's SchemaRegistry and pass the resulting
+// schema UID to NewEASIssuer.
+const DonationSchema = "address donor,uint256 amount,uint256 campaign,uint64 timestamp"
+
+// easABI is the subset of the EAS contract used to issue attestations
+const easABI = `[
+	{"type":"function","name":"attest","stateMutability":"payable",
+	 "inputs":[{"name":"request","type":"tuple","components":[
+		{"name":"schema","type":"bytes32"},
+		{"name":"data","type":"tuple","components":[
+			{"name":"recipient","type":"address"},
+			{"name":"expirationTime","type":"uint64"},
+			{"name":"revocable","type":"bool"},
+			{"name":"refUID","type":"bytes32"},
+			{"name":"data","type":"bytes"},
+			{"name":"value","type":"uint256"}]}]}],
+	 "outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"event","name":"Attested","anonymous":false,
+	 "inputs":[
+		{"name":"recipient","type":"address","indexed":true},
+		{"name":"attester","type":"address","indexed":true},
+		{"name":"uid","type":"bytes32","indexed":false},
+		{"name":"schemaUID","type":"bytes32","indexed":true}]}
+]`
+
+// DonationReceipt is a donation to attest
+type DonationReceipt struct {
+	Donor     string   // donor EVM address; also the attestation recipient
+	Amount    *big.Int // base units
+	Campaign  uint64   // 0 for general donations
+	Timestamp time.Time
+}
+
+// easAttestationData mirrors EAS's AttestationRequestData struct
+type easAttestationData struct {
+	Recipient      common.Address
+	ExpirationTime uint64
+	Revocable      bool
+	RefUID         [32]byte
+	Data           []byte
+	Value          *big.Int
+}
+
+// easAttestationRequest mirrors EAS's AttestationRequest struct
+type easAttestationRequest struct {
+	Schema [32]byte
+	Data   easAttestationData
+}
+
+// EASIssuer posts donation receipts as Ethereum Attestation Service
+// attestations, so donors hold portable proof other dapps can read
+type EASIssuer struct {
+	client   *ethclient.Client
+	address  common.Address
+	contract *bind.BoundContract
+	abi      abi.ABI
+	schema   common.Hash
+	key      *ecdsa.PrivateKey
+	chainID  *big.Int
+	timeout  time.Duration
+}
+
+// NewEASIssuer creates an issuer attesting through the EAS contract at
+// easAddress with the attester key privateKeyHex. schemaUID is the UID of
+// DonationSchema in the chain's SchemaRegistry.
+func NewEASIssuer(ctx context.Context, rpcURL, easAddress, schemaUID, privateKeyHex string) (*EASIssuer, error) {
+	if !common.IsHexAddress(easAddress) {
+		return nil, fmt.Errorf("invalid EAS address %q", easAddress)
+	}
+
+	schema := common.HexToHash(schemaUID)
+	if schema == (common.Hash{}) {
+		return nil, errors.New("schema UID required")
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(easABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EAS ABI: %w", err)
+	}
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", rpcURL, err)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to get chain id: %w", err)
+	}
+
+	address := common.HexToAddress(easAddress)
+	return &EASIssuer{
+		client:   client,
+		address:  address,
+		contract: bind.NewBoundContract(address, parsed, client, client, client),
+		abi:      parsed,
+		schema:   schema,
+		key:      key,
+		chainID:  chainID,
+		timeout:  2 * time.Minute,
+	}, nil
+}
+
+// SetTimeout sets how long Attest waits for the transaction to be mined
+func (ei *EASIssuer) SetTimeout(timeout time.Duration) {
+	ei.timeout = timeout
+}
+
+// Close closes the RPC connection
+func (ei *EASIssuer) Close() {
+	ei.client.Close()
+}
+
+// EncodeDonation ABI-encodes a receipt per DonationSchema
+func EncodeDonation(receipt DonationReceipt) ([]byte, error) {
+	if !common.IsHexAddress(receipt.Donor) {
+		return nil, fmt.Errorf("invalid donor address %q", receipt.Donor)
+	}
+	if receipt.Amount == nil || receipt.Amount.Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	if receipt.Timestamp.IsZero() {
+		return nil, errors.New("timestamp required")
+	}
+
+	uint256, _ := abi.NewType("uint256", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	args := abi.Arguments{{Type: address}, {Type: uint256}, {Type: uint256}, {Type: uint64Type}}
+
+	return args.Pack(
+		common.HexToAddress(receipt.Donor),
+		receipt.Amount,
+		new(big.Int).SetUint64(receipt.Campaign),
+		uint64(receipt.Timestamp.Unix()),
+	)
+}
+
+// Attest posts a non-expiring, revocable attestation for receipt and waits
+// for it to be mined. It returns the attestation UID and transaction hash.
+func (ei *EASIssuer) Attest(ctx context.Context, receipt DonationReceipt) (common.Hash, common.Hash, error) {
+	data, err := EncodeDonation(receipt)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(ei.key, ei.chainID)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, err
+	}
+	opts.Context = ctx
+
+	request := easAttestationRequest{
+		Schema: ei.schema,
+		Data: easAttestationData{
+			Recipient: common.HexToAddress(receipt.Donor),
+			Revocable: true,
+			Data:      data,
+			Value:     big.NewInt(0),
+		},
+	}
+
+	tx, err := ei.contract.Transact(opts, "attest", request)
+	if err != nil {
+		return common.Hash{}, common.Hash{}, fmt.Errorf("failed to send attestation: %w", err)
+	}
+
+	if ei.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ei.timeout)
+		defer cancel()
+	}
+
+	txReceipt, err := bind.WaitMined(ctx, ei.client, tx)
+	if err != nil {
+		return common.Hash{}, tx.Hash(), fmt.Errorf("failed waiting for %s: %w", tx.Hash().Hex(), err)
+	}
+	if txReceipt.Status != types.ReceiptStatusSuccessful {
+		return common.Hash{}, tx.Hash(), fmt.Errorf("attestation %s reverted", tx.Hash().Hex())
+	}
+
+	uid, err := ei.attestedUID(txReceipt)
+	if err != nil {
+		return common.Hash{}, tx.Hash(), err
+	}
+
+	return uid, tx.Hash(), nil
+}
+
+// attestedUID extracts the attestation UID from the Attested event. Only
+// events emitted by the EAS contract count: any contract the attestation
+// calls into (a schema resolver, say) can log a look-alike.
+func (ei *EASIssuer) attestedUID(receipt *types.Receipt) (common.Hash, error) {
+	event := ei.abi.Events["Attested"]
+	for _, log := range receipt.Logs {
+		if log.Address != ei.address || len(log.Topics) == 0 || log.Topics[0] != event.ID {
+			continue
+		}
+
+		values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil || len(values) != 1 {
+			return common.Hash{}, fmt.Errorf("malformed Attested event: %v", err)
+		}
+		uid, ok := values[0].([32]byte)
+		if !ok {
+			return common.Hash{}, errors.New("malformed Attested event uid")
+		}
+		return common.Hash(uid), nil
+	}
+
+	return common.Hash{}, errors.New("no Attested event in receipt")
+}
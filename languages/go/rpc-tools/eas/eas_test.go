@@ -0,0 +1,141 @@
+package eas
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	easAddress = common.HexToAddress("0x4200000000000000000000000000000000000021")
+	donor      = "0x742d35Cc6634C0532925a3b844Bc454e4438f44e"
+	donatedAt  = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func newTestIssuer(t *testing.T) *EASIssuer {
+	t.Helper()
+
+	parsed, err := abi.JSON(strings.NewReader(easABI))
+	if err != nil {
+		t.Fatalf("parse EAS ABI: %v", err)
+	}
+	return &EASIssuer{address: easAddress, abi: parsed}
+}
+
+// attestedLog builds an Attested event for uid as emitted by address
+func attestedLog(t *testing.T, ei *EASIssuer, address common.Address, uid common.Hash) *types.Log {
+	t.Helper()
+
+	event := ei.abi.Events["Attested"]
+	data, err := event.Inputs.NonIndexed().Pack([32]byte(uid))
+	if err != nil {
+		t.Fatalf("pack Attested: %v", err)
+	}
+	return &types.Log{
+		Address: address,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(common.HexToAddress(donor).Bytes()),
+			common.BytesToHash(common.HexToAddress("0x01").Bytes()),
+			common.HexToHash("0x5c"),
+		},
+		Data: data,
+	}
+}
+
+func TestEncodeDonation(t *testing.T) {
+	receipt := DonationReceipt{
+		Donor:     donor,
+		Amount:    big.NewInt(2_000_000),
+		Campaign:  7,
+		Timestamp: donatedAt,
+	}
+
+	data, err := EncodeDonation(receipt)
+	if err != nil {
+		t.Fatalf("EncodeDonation: %v", err)
+	}
+	if len(data) != 4*32 {
+		t.Fatalf("encoded %d bytes, want %d", len(data), 4*32)
+	}
+
+	uint256, _ := abi.NewType("uint256", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	values, err := abi.Arguments{{Type: address}, {Type: uint256}, {Type: uint256}, {Type: uint64Type}}.Unpack(data)
+	if err != nil {
+		t.Fatalf("unpack: %v", err)
+	}
+	if got := values[0].(common.Address); got != common.HexToAddress(donor) {
+		t.Errorf("donor = %s, want %s", got.Hex(), donor)
+	}
+	if got := values[1].(*big.Int); got.Cmp(receipt.Amount) != 0 {
+		t.Errorf("amount = %s, want %s", got, receipt.Amount)
+	}
+	if got := values[2].(*big.Int); got.Uint64() != receipt.Campaign {
+		t.Errorf("campaign = %s, want %d", got, receipt.Campaign)
+	}
+	if got := values[3].(uint64); got != uint64(donatedAt.Unix()) {
+		t.Errorf("timestamp = %d, want %d", got, donatedAt.Unix())
+	}
+
+	invalid := map[string]DonationReceipt{
+		"bad donor":      {Donor: "0x1234", Amount: big.NewInt(1), Timestamp: donatedAt},
+		"no amount":      {Donor: donor, Timestamp: donatedAt},
+		"zero amount":    {Donor: donor, Amount: big.NewInt(0), Timestamp: donatedAt},
+		"zero timestamp": {Donor: donor, Amount: big.NewInt(1)},
+	}
+	for name, receipt := range invalid {
+		if _, err := EncodeDonation(receipt); err == nil {
+			t.Errorf("EncodeDonation accepted a receipt with %s", name)
+		}
+	}
+}
+
+func TestAttestedUID(t *testing.T) {
+	ei := newTestIssuer(t)
+	uid := common.HexToHash("0xabc123")
+
+	got, err := ei.attestedUID(&types.Receipt{Logs: []*types.Log{attestedLog(t, ei, easAddress, uid)}})
+	if err != nil {
+		t.Fatalf("attestedUID: %v", err)
+	}
+	if got != uid {
+		t.Errorf("uid = %s, want %s", got.Hex(), uid.Hex())
+	}
+
+	if _, err := ei.attestedUID(&types.Receipt{}); err == nil {
+		t.Error("attestedUID found a uid in an empty receipt")
+	}
+}
+
+func TestAttestedUIDSpoofedLog(t *testing.T) {
+	ei := newTestIssuer(t)
+	resolver := common.HexToAddress("0x00000000000000000000000000000000000bad01")
+	spoofed := common.HexToHash("0xbad")
+	uid := common.HexToHash("0xabc123")
+
+	// A look-alike logged by another contract ahead of the real event is skipped
+	receipt := &types.Receipt{Logs: []*types.Log{
+		attestedLog(t, ei, resolver, spoofed),
+		attestedLog(t, ei, easAddress, uid),
+	}}
+	got, err := ei.attestedUID(receipt)
+	if err != nil {
+		t.Fatalf("attestedUID: %v", err)
+	}
+	if got != uid {
+		t.Errorf("uid = %s, want %s", got.Hex(), uid.Hex())
+	}
+
+	// On its own it is not mistaken for an attestation
+	receipt = &types.Receipt{Logs: []*types.Log{attestedLog(t, ei, resolver, spoofed)}}
+	if got, err := ei.attestedUID(receipt); err == nil {
+		t.Errorf("attestedUID accepted a spoofed event, uid %s", got.Hex())
+	}
+}
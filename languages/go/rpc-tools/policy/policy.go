@@ -0,0 +1,457 @@
+// Package policy authorizes payouts against a hot-reloaded signing policy
+// before anything is signed.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/web3-showcase/rpc-tools/auditlog"
+	"github.com/web3-showcase/rpc-tools/sigverify"
+)
+
+// PayoutRequest describes a payout awaiting a signature
+type PayoutRequest struct {
+	ID        string
+	Recipient string
+	Amount    *big.Int // base units
+	Approvals []Approval
+}
+
+// Approval is an approver's personal_sign (EIP-191) signature over a
+// payout's ApprovalMessage
+type Approval struct {
+	Approver  string `json:"approver"` // address
+	Signature string `json:"signature"`
+}
+
+// Message returns the canonical message signed for a payout
+func (p PayoutRequest) Message() string {
+	return fmt.Sprintf("payout:%s:%s:%s", p.ID, strings.ToLower(p.Recipient), p.Amount.String())
+}
+
+// ApprovalMessage returns the message approvers sign for a payout. It is
+// distinct from Message so an approval can never pass as the payout
+// signature itself.
+func (p PayoutRequest) ApprovalMessage() string {
+	return "approve:" + p.Message()
+}
+
+// ApprovalBand requires a number of distinct approvers for payouts at or above MinAmount
+type ApprovalBand struct {
+	MinAmount         string `json:"min_amount"`
+	RequiredApprovers int    `json:"required_approvers"`
+}
+
+// SigningPolicy is the on-disk policy file format. Amounts are decimal
+// strings in base units; an empty limit means unlimited. Only approvals
+// signed by one of Approvers count towards an approval band.
+type SigningPolicy struct {
+	MaxPerTx          string         `json:"max_per_tx"`
+	DailyLimit        string         `json:"daily_limit"`
+	AllowedRecipients []string       `json:"allowed_recipients"`
+	Approvers         []string       `json:"approvers"`
+	ApprovalBands     []ApprovalBand `json:"approval_bands"`
+}
+
+// PolicyDecision is the outcome of evaluating a payout against the policy
+type PolicyDecision struct {
+	Allowed bool
+	Reason  string
+}
+
+// compiledPolicy is a parsed and validated SigningPolicy
+type compiledPolicy struct {
+	maxPerTx   *big.Int
+	dailyLimit *big.Int
+	recipients map[string]bool
+	approvers  map[common.Address]bool
+	bands      []compiledBand // sorted by descending minAmount
+}
+
+type compiledBand struct {
+	minAmount *big.Int
+	approvers int
+}
+
+// PolicyEngine evaluates payouts against a policy file before anything is signed
+type PolicyEngine struct {
+	mu      sync.Mutex
+	path    string
+	modTime time.Time
+	policy  *compiledPolicy
+	sv      *sigverify.SignatureVerifier
+	spent   map[string]*big.Int // UTC day -> amount signed
+	logger  *log.Logger
+	audit   *auditlog.AuditLog
+}
+
+// NewPolicyEngine loads the policy file at path
+func NewPolicyEngine(path string, logger *log.Logger) (*PolicyEngine, error) {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	pe := &PolicyEngine{
+		path:   path,
+		sv:     sigverify.NewSignatureVerifier(),
+		spent:  make(map[string]*big.Int),
+		logger: logger,
+	}
+
+	if err := pe.Reload(); err != nil {
+		return nil, err
+	}
+
+	return pe, nil
+}
+
+// Reload re-reads the policy file if it changed since the last load. A
+// broken file leaves the previous policy in place.
+func (pe *PolicyEngine) Reload() error {
+	info, err := os.Stat(pe.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat policy file: %w", err)
+	}
+
+	pe.mu.Lock()
+	unchanged := pe.policy != nil && info.ModTime().Equal(pe.modTime)
+	pe.mu.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	data, err := os.ReadFile(pe.path)
+	if err != nil {
+		return fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	var policy SigningPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return fmt.Errorf("failed to parse policy file: %w", err)
+	}
+
+	compiled, err := compilePolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	pe.mu.Lock()
+	pe.policy = compiled
+	pe.modTime = info.ModTime()
+	audit := pe.audit
+	pe.mu.Unlock()
+
+	pe.logger.Printf("policy: loaded %s", pe.path)
+
+	if audit != nil {
+		sum := sha256.Sum256(data)
+		if _, err := audit.Record("policy.reload", map[string]string{
+			"path":   pe.path,
+			"sha256": hex.EncodeToString(sum[:]),
+		}); err != nil {
+			pe.logger.Printf("policy: failed to audit reload: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// SetAuditLog records every authorization and policy reload in al. With an
+// audit log set, a payout whose record cannot be written is denied. The
+// amount already allowed today is rebuilt from the log's payout.allow
+// records, so a restart does not reset the daily limit.
+func (pe *PolicyEngine) SetAuditLog(al *auditlog.AuditLog) error {
+	var buf bytes.Buffer
+	if err := al.Export(&buf); err != nil {
+		return fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	spent := make(map[string]*big.Int)
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var record auditlog.AuditRecord
+		if err := decoder.Decode(&record); err != nil {
+			return fmt.Errorf("failed to parse audit log: %w", err)
+		}
+		if record.Action != "payout.allow" {
+			continue
+		}
+
+		var details struct {
+			Amount string `json:"amount"`
+			Day    string `json:"day"`
+		}
+		if err := json.Unmarshal(record.Details, &details); err != nil {
+			return fmt.Errorf("failed to parse audit record %d: %w", record.Seq, err)
+		}
+		amount, ok := new(big.Int).SetString(details.Amount, 10)
+		if !ok {
+			return fmt.Errorf("audit record %d has invalid amount %q", record.Seq, details.Amount)
+		}
+		day := details.Day
+		if day == "" {
+			day = dayKey(record.Time)
+		}
+		if spent[day] == nil {
+			spent[day] = new(big.Int)
+		}
+		spent[day].Add(spent[day], amount)
+	}
+
+	today := dayKey(time.Now())
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+	pe.audit = al
+	pe.spent = make(map[string]*big.Int)
+	if spent[today] != nil {
+		pe.spent[today] = spent[today]
+	}
+
+	return nil
+}
+
+// Watch polls the policy file and hot-reloads it until ctx is cancelled
+func (pe *PolicyEngine) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := pe.Reload(); err != nil {
+				pe.logger.Printf("policy: reload failed, keeping previous policy: %v", err)
+			}
+		}
+	}
+}
+
+// Evaluate checks a payout against the policy without recording it
+func (pe *PolicyEngine) Evaluate(req PayoutRequest) PolicyDecision {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	decision := pe.evaluate(req, time.Now())
+	pe.logDecision(req, decision)
+
+	return decision
+}
+
+// Authorize evaluates a payout and, if allowed, counts it against the daily limit
+func (pe *PolicyEngine) Authorize(req PayoutRequest) PolicyDecision {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	now := time.Now()
+	decision := pe.evaluate(req, now)
+	if err := pe.auditDecision(req, decision, now); err != nil {
+		decision = PolicyDecision{Reason: "audit log unavailable"}
+	}
+	if decision.Allowed {
+		day := dayKey(now)
+		for d := range pe.spent {
+			if d != day {
+				delete(pe.spent, d)
+			}
+		}
+		if pe.spent[day] == nil {
+			pe.spent[day] = new(big.Int)
+		}
+		pe.spent[day].Add(pe.spent[day], req.Amount)
+	}
+	pe.logDecision(req, decision)
+
+	return decision
+}
+
+func (pe *PolicyEngine) evaluate(req PayoutRequest, now time.Time) PolicyDecision {
+	p := pe.policy
+
+	if req.Amount == nil || req.Amount.Sign() <= 0 {
+		return PolicyDecision{Reason: "amount must be positive"}
+	}
+
+	if len(p.recipients) > 0 && !p.recipients[strings.ToLower(req.Recipient)] {
+		return PolicyDecision{Reason: "recipient not allowed"}
+	}
+
+	if p.maxPerTx != nil && req.Amount.Cmp(p.maxPerTx) > 0 {
+		return PolicyDecision{Reason: fmt.Sprintf("amount exceeds per-tx max %s", p.maxPerTx)}
+	}
+
+	if p.dailyLimit != nil {
+		total := new(big.Int).Set(req.Amount)
+		if spent := pe.spent[dayKey(now)]; spent != nil {
+			total.Add(total, spent)
+		}
+		if total.Cmp(p.dailyLimit) > 0 {
+			return PolicyDecision{Reason: fmt.Sprintf("daily limit %s exceeded", p.dailyLimit)}
+		}
+	}
+
+	for _, band := range p.bands {
+		if req.Amount.Cmp(band.minAmount) < 0 {
+			continue
+		}
+		if n := pe.countApprovals(req); n < band.approvers {
+			return PolicyDecision{Reason: fmt.Sprintf("requires %d approvers, got %d", band.approvers, n)}
+		}
+		break
+	}
+
+	return PolicyDecision{Allowed: true, Reason: "ok"}
+}
+
+// countApprovals returns the number of distinct configured approvers with a
+// valid signature over the payout's approval message
+func (pe *PolicyEngine) countApprovals(req PayoutRequest) int {
+	message := req.ApprovalMessage()
+	counted := make(map[common.Address]bool, len(req.Approvals))
+
+	for _, approval := range req.Approvals {
+		if !common.IsHexAddress(approval.Approver) {
+			continue
+		}
+		approver := common.HexToAddress(approval.Approver)
+		if counted[approver] || !pe.policy.approvers[approver] {
+			continue
+		}
+		valid, err := pe.sv.VerifyPersonal(message, approval.Signature, approver.Hex())
+		if err != nil || !valid {
+			continue
+		}
+		counted[approver] = true
+	}
+
+	return len(counted)
+}
+
+func (pe *PolicyEngine) logDecision(req PayoutRequest, decision PolicyDecision) {
+	verdict := "DENY"
+	if decision.Allowed {
+		verdict = "ALLOW"
+	}
+	pe.logger.Printf("policy: %s payout=%s recipient=%s amount=%s approvals=%d reason=%q",
+		verdict, req.ID, req.Recipient, req.Amount, len(req.Approvals), decision.Reason)
+}
+
+// auditDecision records an authorization decision, if an audit log is set,
+// along with the UTC day of now that an allowed payout counts against
+func (pe *PolicyEngine) auditDecision(req PayoutRequest, decision PolicyDecision, now time.Time) error {
+	if pe.audit == nil {
+		return nil
+	}
+
+	action := "payout.deny"
+	if decision.Allowed {
+		action = "payout.allow"
+	}
+
+	_, err := pe.audit.Record(action, map[string]interface{}{
+		"payout":    req.ID,
+		"recipient": req.Recipient,
+		"amount":    req.Amount.String(),
+		"day":       dayKey(now),
+		"approvals": req.Approvals,
+		"reason":    decision.Reason,
+	})
+	if err != nil {
+		pe.logger.Printf("policy: failed to audit payout=%s: %v", req.ID, err)
+	}
+	return err
+}
+
+// SignPayout signs a payout with privateKeyHex only if the policy engine
+// authorizes it
+func (pe *PolicyEngine) SignPayout(req PayoutRequest, privateKeyHex string) (string, error) {
+	if decision := pe.Authorize(req); !decision.Allowed {
+		return "", fmt.Errorf("payout rejected by policy: %s", decision.Reason)
+	}
+
+	return sigverify.NewSignatureVerifier().SignMessage(req.Message(), privateKeyHex)
+}
+
+func compilePolicy(policy SigningPolicy) (*compiledPolicy, error) {
+	maxPerTx, err := parseLimit(policy.MaxPerTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid max_per_tx: %w", err)
+	}
+
+	dailyLimit, err := parseLimit(policy.DailyLimit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid daily_limit: %w", err)
+	}
+
+	recipients := make(map[string]bool, len(policy.AllowedRecipients))
+	for _, r := range policy.AllowedRecipients {
+		recipients[strings.ToLower(r)] = true
+	}
+
+	approvers := make(map[common.Address]bool, len(policy.Approvers))
+	for _, a := range policy.Approvers {
+		if !common.IsHexAddress(a) {
+			return nil, fmt.Errorf("invalid approver address %q", a)
+		}
+		approvers[common.HexToAddress(a)] = true
+	}
+
+	bands := make([]compiledBand, 0, len(policy.ApprovalBands))
+	for _, b := range policy.ApprovalBands {
+		minAmount, ok := new(big.Int).SetString(b.MinAmount, 10)
+		if !ok || minAmount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid approval band min_amount %q", b.MinAmount)
+		}
+		if b.RequiredApprovers < 0 {
+			return nil, errors.New("required_approvers must not be negative")
+		}
+		if b.RequiredApprovers > len(approvers) {
+			return nil, fmt.Errorf("approval band %s requires %d approvers but only %d are configured",
+				b.MinAmount, b.RequiredApprovers, len(approvers))
+		}
+		bands = append(bands, compiledBand{minAmount: minAmount, approvers: b.RequiredApprovers})
+	}
+	sort.Slice(bands, func(i, j int) bool {
+		return bands[i].minAmount.Cmp(bands[j].minAmount) > 0
+	})
+
+	return &compiledPolicy{
+		maxPerTx:   maxPerTx,
+		dailyLimit: dailyLimit,
+		recipients: recipients,
+		approvers:  approvers,
+		bands:      bands,
+	}, nil
+}
+
+func parseLimit(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative integer", s)
+	}
+
+	return v, nil
+}
+
+func dayKey(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
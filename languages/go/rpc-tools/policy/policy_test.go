@@ -0,0 +1,184 @@
+package policy
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/web3-showcase/rpc-tools/auditlog"
+	"github.com/web3-showcase/rpc-tools/sigverify"
+)
+
+const recipient = "0x742d35Cc6634C0532925a3b844Bc454e4438f44e"
+
+var ether = big.NewInt(1_000_000_000_000_000_000)
+
+type approver struct {
+	key, address string
+}
+
+func newApprovers(t *testing.T, n int) []approver {
+	t.Helper()
+	approvers := make([]approver, n)
+	for i := range approvers {
+		key, address, err := sigverify.NewSignatureVerifier().GeneratePrivateKey()
+		if err != nil {
+			t.Fatalf("GeneratePrivateKey: %v", err)
+		}
+		approvers[i] = approver{key, address}
+	}
+	return approvers
+}
+
+func (a approver) approve(t *testing.T, req PayoutRequest) Approval {
+	t.Helper()
+	signature, err := sigverify.NewSignatureVerifier().SignPersonal(req.ApprovalMessage(), a.key)
+	if err != nil {
+		t.Fatalf("SignPersonal: %v", err)
+	}
+	return Approval{Approver: a.address, Signature: signature}
+}
+
+// writePolicy writes policy to a new file in dir and returns its path
+func writePolicy(t *testing.T, dir string, policy SigningPolicy) string {
+	t.Helper()
+	data, err := json.Marshal(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "policy.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newEngine(t *testing.T, path string) *PolicyEngine {
+	t.Helper()
+	pe, err := NewPolicyEngine(path, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewPolicyEngine: %v", err)
+	}
+	return pe
+}
+
+func payout(id string, amount int64) PayoutRequest {
+	return PayoutRequest{
+		ID:        id,
+		Recipient: recipient,
+		Amount:    new(big.Int).Mul(big.NewInt(amount), ether),
+	}
+}
+
+func TestSignedApprovals(t *testing.T) {
+	approvers := newApprovers(t, 3)
+	outsider := newApprovers(t, 1)[0]
+
+	path := writePolicy(t, t.TempDir(), SigningPolicy{
+		Approvers: []string{approvers[0].address, approvers[1].address, approvers[2].address},
+		ApprovalBands: []ApprovalBand{
+			{MinAmount: ether.String(), RequiredApprovers: 2},
+		},
+	})
+	pe := newEngine(t, path)
+
+	req := payout("payout-1", 2)
+	other := payout("payout-1", 3)
+
+	tests := []struct {
+		name      string
+		approvals []Approval
+		allowed   bool
+	}{
+		{"two approvers", []Approval{approvers[0].approve(t, req), approvers[2].approve(t, req)}, true},
+		{"one approver", []Approval{approvers[0].approve(t, req)}, false},
+		{"same approver twice", []Approval{approvers[0].approve(t, req), approvers[0].approve(t, req)}, false},
+		{"unconfigured approver", []Approval{approvers[0].approve(t, req), outsider.approve(t, req)}, false},
+		{"unsigned names", []Approval{{Approver: approvers[0].address}, {Approver: approvers[1].address}}, false},
+		{"approval of another payout", []Approval{approvers[0].approve(t, req), approvers[1].approve(t, other)}, false},
+		{"signature under another name", []Approval{
+			approvers[0].approve(t, req),
+			{Approver: approvers[1].address, Signature: approvers[0].approve(t, req).Signature},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req.Approvals = tt.approvals
+			decision := pe.Evaluate(req)
+			if decision.Allowed != tt.allowed {
+				t.Errorf("Evaluate = %+v, want allowed %v", decision, tt.allowed)
+			}
+		})
+	}
+
+	// Payouts below every band need no approvals
+	if decision := pe.Evaluate(PayoutRequest{ID: "small", Recipient: recipient, Amount: big.NewInt(1)}); !decision.Allowed {
+		t.Errorf("small payout denied: %s", decision.Reason)
+	}
+}
+
+func TestPolicyRejectsUnsatisfiableBands(t *testing.T) {
+	approvers := newApprovers(t, 1)
+
+	tests := map[string]SigningPolicy{
+		"too few approvers": {
+			Approvers:     []string{approvers[0].address},
+			ApprovalBands: []ApprovalBand{{MinAmount: "1", RequiredApprovers: 2}},
+		},
+		"invalid approver": {
+			Approvers: []string{"alice"},
+		},
+	}
+
+	for name, policy := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writePolicy(t, t.TempDir(), policy)
+			if _, err := NewPolicyEngine(path, log.New(io.Discard, "", 0)); err == nil {
+				t.Error("NewPolicyEngine accepted the policy")
+			}
+		})
+	}
+}
+
+func TestDailySpendSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	path := writePolicy(t, dir, SigningPolicy{DailyLimit: new(big.Int).Mul(big.NewInt(5), ether).String()})
+	auditPath := filepath.Join(dir, "audit.jsonl")
+	operatorKey := newApprovers(t, 1)[0].key
+
+	open := func() *PolicyEngine {
+		pe := newEngine(t, path)
+		audit, err := auditlog.NewAuditLog(auditPath, operatorKey)
+		if err != nil {
+			t.Fatalf("NewAuditLog: %v", err)
+		}
+		if err := pe.SetAuditLog(audit); err != nil {
+			t.Fatalf("SetAuditLog: %v", err)
+		}
+		return pe
+	}
+
+	pe := open()
+	if decision := pe.Authorize(payout("payout-1", 3)); !decision.Allowed {
+		t.Fatalf("first payout denied: %s", decision.Reason)
+	}
+	if decision := pe.Authorize(payout("payout-2", 4)); decision.Allowed {
+		t.Fatal("payout over the daily limit allowed")
+	}
+
+	// A restarted engine still counts the 3 ether allowed today
+	pe = open()
+	decision := pe.Authorize(payout("payout-3", 3))
+	if decision.Allowed || !strings.Contains(decision.Reason, "daily limit") {
+		t.Errorf("after restart, payout over the daily limit = %+v", decision)
+	}
+	if decision := pe.Authorize(payout("payout-4", 2)); !decision.Allowed {
+		t.Errorf("after restart, payout within the daily limit denied: %s", decision.Reason)
+	}
+}
@@ -0,0 +1,288 @@
+// Package roundup collects purchase round-ups off-chain and settles them
+// as batched on-chain donations.
+package roundup
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+// RoundUp returns the donation that rounds purchase up to the next multiple
+// of unit, e.g. a 4.30 purchase with a unit of 1.00 donates 0.70. A purchase
+// that is already a multiple donates nothing.
+func RoundUp(purchase, unit *big.Int) (*big.Int, error) {
+	if purchase == nil || purchase.Sign() <= 0 {
+		return nil, errors.New("purchase must be positive")
+	}
+	if unit == nil || unit.Sign() <= 0 {
+		return nil, errors.New("rounding unit must be positive")
+	}
+
+	remainder := new(big.Int).Mod(purchase, unit)
+	if remainder.Sign() == 0 {
+		return new(big.Int), nil
+	}
+	return remainder.Sub(unit, remainder), nil
+}
+
+// RoundUpItem is one itemized round-up, kept off-chain
+type RoundUpItem struct {
+	ID        string    `json:"id"` // purchase reference, unique
+	Donor     string    `json:"donor"`
+	Purchase  string    `json:"purchase"` // base units
+	Amount    string    `json:"amount"`   // donated base units
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RoundUpSettlement records one on-chain donation settling a batch of items
+type RoundUpSettlement struct {
+	TxHash    string    `json:"tx_hash"`
+	Total     string    `json:"total"`
+	ItemIDs   []string  `json:"item_ids"`
+	SettledAt time.Time `json:"settled_at"`
+}
+
+// roundUpEntry is a line of the ledger file: an item or a settlement
+type roundUpEntry struct {
+	Item       *RoundUpItem       `json:"item,omitempty"`
+	Settlement *RoundUpSettlement `json:"settlement,omitempty"`
+}
+
+// SettleFunc submits a single donation of total for the batch and returns
+// its transaction hash
+type SettleFunc func(ctx context.Context, total *big.Int, items []RoundUpItem) (string, error)
+
+// RoundUpLedger accumulates micro round-ups and settles them in batches as
+// one on-chain donation. Items and settlements are appended to a JSON-lines
+// file, so every donated cent can be traced to a purchase and a transaction.
+type RoundUpLedger struct {
+	mu         sync.Mutex
+	path       string
+	unit       *big.Int
+	pending    []RoundUpItem
+	seen       map[string]bool
+	settling   bool
+	unrecorded []RoundUpSettlement // settled on-chain, not yet in the file
+}
+
+// NewRoundUpLedger opens (or creates) the ledger at path, rounding to unit.
+// Items not covered by a settlement in the file are pending again.
+func NewRoundUpLedger(path string, unit *big.Int) (*RoundUpLedger, error) {
+	if unit == nil || unit.Sign() <= 0 {
+		return nil, errors.New("rounding unit must be positive")
+	}
+
+	l := &RoundUpLedger{
+		path: path,
+		unit: new(big.Int).Set(unit),
+		seen: make(map[string]bool),
+	}
+
+	if err := l.load(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Add records the round-up of a purchase and returns the item. Purchases
+// that need no rounding are not recorded and return a zero amount.
+func (l *RoundUpLedger) Add(id, donor string, purchase *big.Int, at time.Time) (RoundUpItem, error) {
+	if id == "" {
+		return RoundUpItem{}, errors.New("purchase id required")
+	}
+
+	amount, err := RoundUp(purchase, l.unit)
+	if err != nil {
+		return RoundUpItem{}, err
+	}
+
+	item := RoundUpItem{
+		ID:        id,
+		Donor:     donor,
+		Purchase:  purchase.String(),
+		Amount:    amount.String(),
+		CreatedAt: at.UTC(),
+	}
+	if amount.Sign() == 0 {
+		return item, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.seen[id] {
+		return RoundUpItem{}, fmt.Errorf("purchase %s already rounded up", id)
+	}
+
+	if err := l.append(roundUpEntry{Item: &item}); err != nil {
+		return RoundUpItem{}, err
+	}
+
+	l.seen[id] = true
+	l.pending = append(l.pending, item)
+
+	return item, nil
+}
+
+// Pending returns the unsettled items and their total
+func (l *RoundUpLedger) Pending() ([]RoundUpItem, *big.Int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	items := append([]RoundUpItem(nil), l.pending...)
+	return items, sumRoundUps(items)
+}
+
+// Settle donates all pending round-ups in one transaction via settle and
+// records the settlement. If settle fails the items stay pending. Settling
+// with nothing pending returns a nil settlement.
+//
+// settle runs without holding the ledger, so Add and Pending keep working
+// while the transaction confirms; items added meanwhile go to the next batch.
+// Only one settlement runs at a time. Once settle succeeds the batch is no
+// longer pending, even if the settlement cannot be written: it is kept in
+// memory and written before the next batch is settled.
+func (l *RoundUpLedger) Settle(ctx context.Context, settle SettleFunc) (*RoundUpSettlement, error) {
+	l.mu.Lock()
+	if l.settling {
+		l.mu.Unlock()
+		return nil, errors.New("settlement already in progress")
+	}
+	if err := l.recordUnrecorded(); err != nil {
+		l.mu.Unlock()
+		return nil, err
+	}
+	if len(l.pending) == 0 {
+		l.mu.Unlock()
+		return nil, nil
+	}
+
+	batch := append([]RoundUpItem(nil), l.pending...)
+	l.settling = true
+	l.mu.Unlock()
+
+	total := sumRoundUps(batch)
+	txHash, err := settle(ctx, total, append([]RoundUpItem(nil), batch...))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.settling = false
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to settle %d round-ups: %w", len(batch), err)
+	}
+
+	settlement := RoundUpSettlement{
+		TxHash:    txHash,
+		Total:     total.String(),
+		SettledAt: time.Now().UTC(),
+	}
+	for _, item := range batch {
+		settlement.ItemIDs = append(settlement.ItemIDs, item.ID)
+	}
+
+	// The donation is on-chain at this point, so the batch must never be
+	// settled again; Add only appends, so it is still a prefix of pending
+	l.pending = append([]RoundUpItem(nil), l.pending[len(batch):]...)
+
+	if err := l.append(roundUpEntry{Settlement: &settlement}); err != nil {
+		l.unrecorded = append(l.unrecorded, settlement)
+		return &settlement, fmt.Errorf("settled in %s but failed to record it: %w", txHash, err)
+	}
+
+	return &settlement, nil
+}
+
+// recordUnrecorded writes settlements whose earlier write failed. Until it
+// succeeds no new batch is settled, because a restart would find their
+// items pending in the file.
+func (l *RoundUpLedger) recordUnrecorded() error {
+	for len(l.unrecorded) > 0 {
+		settlement := l.unrecorded[0]
+		if err := l.append(roundUpEntry{Settlement: &settlement}); err != nil {
+			return fmt.Errorf("failed to record settlement %s: %w", settlement.TxHash, err)
+		}
+		l.unrecorded = l.unrecorded[1:]
+	}
+	return nil
+}
+
+func (l *RoundUpLedger) load() error {
+	file, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open round-up ledger: %w", err)
+	}
+	defer file.Close()
+
+	settled := make(map[string]bool)
+	var items []RoundUpItem
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		var entry roundUpEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("failed to parse round-up ledger line %d: %w", line, err)
+		}
+		switch {
+		case entry.Item != nil:
+			items = append(items, *entry.Item)
+			l.seen[entry.Item.ID] = true
+		case entry.Settlement != nil:
+			for _, id := range entry.Settlement.ItemIDs {
+				settled[id] = true
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read round-up ledger: %w", err)
+	}
+
+	for _, item := range items {
+		if !settled[item.ID] {
+			l.pending = append(l.pending, item)
+		}
+	}
+
+	return nil
+}
+
+func (l *RoundUpLedger) append(entry roundUpEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open round-up ledger: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write round-up ledger: %w", err)
+	}
+
+	return file.Sync()
+}
+
+func sumRoundUps(items []RoundUpItem) *big.Int {
+	total := new(big.Int)
+	for _, item := range items {
+		amount, ok := new(big.Int).SetString(item.Amount, 10)
+		if ok {
+			total.Add(total, amount)
+		}
+	}
+	return total
+}
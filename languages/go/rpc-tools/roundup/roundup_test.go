@@ -0,0 +1,189 @@
+package roundup
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var (
+	usdc    = big.NewInt(1_000_000)
+	addedAt = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func newTestLedger(t *testing.T) (*RoundUpLedger, string) {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "ledger")
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "roundups.jsonl")
+
+	ledger, err := NewRoundUpLedger(path, usdc)
+	if err != nil {
+		t.Fatalf("NewRoundUpLedger: %v", err)
+	}
+	return ledger, path
+}
+
+func addPurchase(t *testing.T, ledger *RoundUpLedger, id string, purchase int64) {
+	t.Helper()
+
+	if _, err := ledger.Add(id, "donor", big.NewInt(purchase), addedAt); err != nil {
+		t.Fatalf("Add(%s): %v", id, err)
+	}
+}
+
+func TestRoundUp(t *testing.T) {
+	tests := []struct {
+		purchase, want int64
+	}{
+		{4_300_000, 700_000},
+		{5_000_000, 0},
+		{1, 999_999},
+	}
+	for _, tt := range tests {
+		got, err := RoundUp(big.NewInt(tt.purchase), usdc)
+		if err != nil || got.Int64() != tt.want {
+			t.Errorf("RoundUp(%d) = %v, %v, want %d", tt.purchase, got, err, tt.want)
+		}
+	}
+
+	if _, err := RoundUp(big.NewInt(0), usdc); err == nil {
+		t.Error("RoundUp accepted a zero purchase")
+	}
+}
+
+func TestSettle(t *testing.T) {
+	ledger, path := newTestLedger(t)
+	addPurchase(t, ledger, "order-1", 4_300_000)
+	addPurchase(t, ledger, "order-2", 2_750_000)
+
+	failing := func(ctx context.Context, total *big.Int, items []RoundUpItem) (string, error) {
+		return "", errors.New("nonce too low")
+	}
+	if _, err := ledger.Settle(context.Background(), failing); err == nil {
+		t.Fatal("Settle succeeded with a failing settle function")
+	}
+	if items, _ := ledger.Pending(); len(items) != 2 {
+		t.Fatalf("failed settle left %d items pending, want 2", len(items))
+	}
+
+	settlement, err := ledger.Settle(context.Background(), func(ctx context.Context, total *big.Int, items []RoundUpItem) (string, error) {
+		if total.Int64() != 950_000 || len(items) != 2 {
+			t.Errorf("settle got total %v for %d items", total, len(items))
+		}
+		return "0xabc", nil
+	})
+	if err != nil {
+		t.Fatalf("Settle: %v", err)
+	}
+	if settlement.TxHash != "0xabc" || settlement.Total != "950000" || len(settlement.ItemIDs) != 2 {
+		t.Errorf("unexpected settlement %+v", settlement)
+	}
+
+	if items, total := ledger.Pending(); len(items) != 0 || total.Sign() != 0 {
+		t.Errorf("Pending after settle = %d items, %v", len(items), total)
+	}
+
+	reopened, err := NewRoundUpLedger(path, usdc)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if items, _ := reopened.Pending(); len(items) != 0 {
+		t.Errorf("reopened ledger has %d pending items", len(items))
+	}
+	if _, err := reopened.Add("order-1", "donor", big.NewInt(4_300_000), addedAt); err == nil {
+		t.Error("reopened ledger accepted a duplicate purchase")
+	}
+}
+
+func TestSettleRunsOutsideLock(t *testing.T) {
+	ledger, _ := newTestLedger(t)
+	addPurchase(t, ledger, "order-1", 4_300_000)
+
+	settlement, err := ledger.Settle(context.Background(), func(ctx context.Context, total *big.Int, items []RoundUpItem) (string, error) {
+		// Would deadlock if settle ran under the ledger lock
+		addPurchase(t, ledger, "order-2", 2_750_000)
+		if pending, _ := ledger.Pending(); len(pending) != 2 {
+			t.Errorf("Pending during settle = %d items, want 2", len(pending))
+		}
+
+		if _, err := ledger.Settle(ctx, func(context.Context, *big.Int, []RoundUpItem) (string, error) {
+			t.Error("concurrent settlement ran")
+			return "", nil
+		}); err == nil {
+			t.Error("concurrent Settle succeeded")
+		}
+		return "0xabc", nil
+	})
+	if err != nil {
+		t.Fatalf("Settle: %v", err)
+	}
+	if len(settlement.ItemIDs) != 1 || settlement.ItemIDs[0] != "order-1" {
+		t.Errorf("settlement covered %v, want [order-1]", settlement.ItemIDs)
+	}
+
+	items, _ := ledger.Pending()
+	if len(items) != 1 || items[0].ID != "order-2" {
+		t.Errorf("Pending after settle = %+v, want order-2 only", items)
+	}
+}
+
+func TestSettleRecordFailure(t *testing.T) {
+	ledger, path := newTestLedger(t)
+	addPurchase(t, ledger, "order-1", 4_300_000)
+
+	// Move the ledger directory away while the donation is in flight, so
+	// the settlement cannot be written
+	dir := filepath.Dir(path)
+	moved := dir + ".moved"
+	settled := 0
+	settle := func(ctx context.Context, total *big.Int, items []RoundUpItem) (string, error) {
+		settled++
+		if err := os.Rename(dir, moved); err != nil {
+			t.Fatal(err)
+		}
+		return "0xabc", nil
+	}
+
+	settlement, err := ledger.Settle(context.Background(), settle)
+	if err == nil {
+		t.Fatal("Settle did not report the failed write")
+	}
+	if settlement == nil || settlement.TxHash != "0xabc" {
+		t.Fatalf("Settle returned settlement %+v", settlement)
+	}
+	if items, _ := ledger.Pending(); len(items) != 0 {
+		t.Fatalf("settled items still pending: %+v", items)
+	}
+
+	// The batch is never settled twice, and nothing new settles until the
+	// settlement is on file
+	if _, err := ledger.Settle(context.Background(), settle); err == nil {
+		t.Error("Settle succeeded with an unrecorded settlement")
+	}
+	if settled != 1 {
+		t.Fatalf("settle called %d times, want 1", settled)
+	}
+
+	if err := os.Rename(moved, dir); err != nil {
+		t.Fatal(err)
+	}
+	if settlement, err := ledger.Settle(context.Background(), settle); err != nil || settlement != nil {
+		t.Fatalf("Settle after recovery = %+v, %v", settlement, err)
+	}
+
+	reopened, err := NewRoundUpLedger(path, usdc)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if items, _ := reopened.Pending(); len(items) != 0 {
+		t.Errorf("reopened ledger has %d pending items", len(items))
+	}
+}
@@ -0,0 +1,70 @@
+package sigverify
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VerificationRequest is one signature to check in a batch
+type VerificationRequest struct {
+	Message   string
+	Signature string
+	Address   string // expected signer
+}
+
+// VerificationResult is the outcome of one VerificationRequest
+type VerificationResult struct {
+	Valid     bool
+	Recovered string // checksummed address that made the signature, empty on error
+	Err       error  // malformed signature; Valid is false
+}
+
+// VerifyBatch verifies requests in parallel on a pool of sv.BatchWorkers
+// workers and returns a result per request, in request order. Each message
+// is hashed under the verifier's scheme, as in VerifySignature. A malformed
+// request only fails its own result.
+func (sv *SignatureVerifier) VerifyBatch(requests []VerificationRequest) []VerificationResult {
+	results := make([]VerificationResult, len(requests))
+
+	workers := sv.BatchWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(requests) {
+		workers = len(requests)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = sv.verifyRequest(requests[i])
+			}
+		}()
+	}
+
+	for i := range requests {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// verifyRequest verifies a single batch request
+func (sv *SignatureVerifier) verifyRequest(req VerificationRequest) VerificationResult {
+	recovered, err := recoverHash(sv.Scheme.hash(req.Message), req.Signature)
+	if err != nil {
+		return VerificationResult{Err: err}
+	}
+	return VerificationResult{
+		Valid:     recovered == common.HexToAddress(req.Address),
+		Recovered: recovered.Hex(),
+	}
+}
@@ -0,0 +1,101 @@
+package sigverify
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVerifyBatch(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	otherKey, otherAddress, err := sv.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("GeneratePrivateKey: %v", err)
+	}
+
+	// Mix valid, wrong-signer, tampered and malformed requests
+	requests := make([]VerificationRequest, 1000)
+	for i := range requests {
+		message := fmt.Sprintf("receipt #%d", i)
+		key := testPrivateKey
+		if i%4 == 1 {
+			key = otherKey
+		}
+		signature, err := sv.SignMessage(message, key)
+		if err != nil {
+			t.Fatalf("SignMessage: %v", err)
+		}
+		switch i % 4 {
+		case 2:
+			message += " (edited)"
+		case 3:
+			signature = "0x1234"
+		}
+		requests[i] = VerificationRequest{Message: message, Signature: signature, Address: testAddress}
+	}
+
+	for _, workers := range []int{0, 1, 7} {
+		sv.BatchWorkers = workers
+		results := sv.VerifyBatch(requests)
+		if len(results) != len(requests) {
+			t.Fatalf("workers=%d: got %d results, want %d", workers, len(results), len(requests))
+		}
+
+		for i, result := range results {
+			switch i % 4 {
+			case 0:
+				if !result.Valid || result.Err != nil || result.Recovered != testAddress {
+					t.Errorf("workers=%d, request %d: want valid, got %+v", workers, i, result)
+				}
+			case 1:
+				if result.Valid || result.Err != nil || result.Recovered != otherAddress {
+					t.Errorf("workers=%d, request %d: want signed by %s, got %+v", workers, i, otherAddress, result)
+				}
+			case 2:
+				if result.Valid || result.Err != nil {
+					t.Errorf("workers=%d, request %d: want invalid, got %+v", workers, i, result)
+				}
+			case 3:
+				if result.Valid || result.Err == nil || result.Recovered != "" {
+					t.Errorf("workers=%d, request %d: want an error, got %+v", workers, i, result)
+				}
+			}
+		}
+	}
+}
+
+func TestVerifyBatchMatchesVerifySignature(t *testing.T) {
+	sv := NewSignatureVerifierWithScheme(HashPersonal)
+
+	requests := make([]VerificationRequest, 50)
+	for i := range requests {
+		message := fmt.Sprintf("donation %d", i)
+		signature, err := sv.SignPersonal(message, testPrivateKey)
+		if err != nil {
+			t.Fatalf("SignPersonal: %v", err)
+		}
+		if i%2 == 1 {
+			signature, err = CompactSignature(signature)
+			if err != nil {
+				t.Fatalf("CompactSignature: %v", err)
+			}
+		}
+		requests[i] = VerificationRequest{Message: message, Signature: signature, Address: testAddress}
+	}
+
+	for i, result := range sv.VerifyBatch(requests) {
+		valid, err := sv.VerifySignature(requests[i].Message, requests[i].Signature, requests[i].Address)
+		if result.Valid != valid || (result.Err == nil) != (err == nil) {
+			t.Errorf("request %d: batch %+v, single (%v, %v)", i, result, valid, err)
+		}
+		if !result.Valid {
+			t.Errorf("request %d: want valid", i)
+		}
+	}
+}
+
+func TestVerifyBatchEmpty(t *testing.T) {
+	if results := NewSignatureVerifier().VerifyBatch(nil); len(results) != 0 {
+		t.Errorf("got %d results for an empty batch", len(results))
+	}
+}
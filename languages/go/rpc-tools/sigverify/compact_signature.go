@@ -0,0 +1,67 @@
+package sigverify
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CompactSignature converts a 65-byte r || s || v signature to the 64-byte
+// EIP-2098 form r || yParityAndS, where the top bit of s carries the
+// y-parity. v may be 0, 1, 27 or 28. s must be in the lower half of the
+// curve order, as it is for every signature produced by SignMessage.
+func CompactSignature(signature string) (string, error) {
+	sigBytes, err := hexutil.Decode(signature)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(sigBytes) != 65 {
+		return "", errors.New("invalid signature length")
+	}
+
+	compact, err := toCompact(sigBytes)
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(compact), nil
+}
+
+// ExpandSignature converts a 64-byte EIP-2098 signature to the 65-byte
+// r || s || v form with v of 27 or 28
+func ExpandSignature(compact string) (string, error) {
+	sigBytes, err := hexutil.Decode(compact)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(sigBytes) != 64 {
+		return "", errors.New("invalid compact signature length")
+	}
+
+	return hexutil.Encode(fromCompact(sigBytes)), nil
+}
+
+// toCompact encodes a 65-byte signature in EIP-2098 form
+func toCompact(sig []byte) ([]byte, error) {
+	normalized, err := normalizeSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+	if normalized[32]&0x80 != 0 {
+		return nil, errors.New("s is not in the lower half of the curve order")
+	}
+
+	compact := normalized[:64]
+	compact[32] |= normalized[64] << 7
+	return compact, nil
+}
+
+// fromCompact decodes an EIP-2098 signature to a new 65-byte signature
+// with v of 27 or 28
+func fromCompact(compact []byte) []byte {
+	sig := make([]byte, 65)
+	copy(sig, compact)
+	sig[64] = 27 + compact[32]>>7
+	sig[32] &= 0x7f
+	return sig
+}
@@ -0,0 +1,112 @@
+package sigverify
+
+import (
+	"testing"
+)
+
+// Test vectors from EIP-2098, personal_sign signatures by eip2098Key
+const eip2098Key = "0x1234567890123456789012345678901234567890123456789012345678901234"
+
+var eip2098Vectors = []struct {
+	message string
+	full    string
+	compact string
+}{
+	{
+		message: "Hello World",
+		full:    "0x68a020a209d3d56c46f38cc50a33f704f4a9a10a59377f8dd762ac66910e9b907e865ad05c4035ab5792787d4a0297a43617ae897930a6fe4d822b8faea520641b",
+		compact: "0x68a020a209d3d56c46f38cc50a33f704f4a9a10a59377f8dd762ac66910e9b907e865ad05c4035ab5792787d4a0297a43617ae897930a6fe4d822b8faea52064",
+	},
+	{
+		message: "It's a small(er) world",
+		full:    "0x9328da16089fcba9bececa81663203989f2df5fe1faa6291a45381c81bd17f76139c6d6b623b42da56557e5e734a43dc83345ddfadec52cbe24d0cc64f5507931c",
+		compact: "0x9328da16089fcba9bececa81663203989f2df5fe1faa6291a45381c81bd17f76939c6d6b623b42da56557e5e734a43dc83345ddfadec52cbe24d0cc64f550793",
+	},
+}
+
+func TestCompactSignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	for _, vector := range eip2098Vectors {
+		signature, err := sv.SignPersonal(vector.message, eip2098Key)
+		if err != nil {
+			t.Fatalf("SignPersonal: %v", err)
+		}
+		if signature != vector.full {
+			t.Errorf("SignPersonal(%q) = %s, want %s", vector.message, signature, vector.full)
+		}
+
+		compact, err := CompactSignature(vector.full)
+		if err != nil {
+			t.Fatalf("CompactSignature: %v", err)
+		}
+		if compact != vector.compact {
+			t.Errorf("CompactSignature(%q) = %s, want %s", vector.message, compact, vector.compact)
+		}
+	}
+
+	// v of 0 or 1 is accepted as well
+	zeroV := eip2098Vectors[1].full[:130] + "01"
+	if compact, err := CompactSignature(zeroV); err != nil || compact != eip2098Vectors[1].compact {
+		t.Errorf("CompactSignature with v = 1: %s, %v", compact, err)
+	}
+
+	invalid := map[string]string{
+		"length":   eip2098Vectors[0].compact,
+		"recovery": eip2098Vectors[0].full[:130] + "1d",
+		"high s":   eip2098Vectors[0].full[:66] + "ff" + eip2098Vectors[0].full[68:],
+		"non-hex":  "0xzz",
+	}
+	for name, signature := range invalid {
+		if _, err := CompactSignature(signature); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestExpandSignature(t *testing.T) {
+	for _, vector := range eip2098Vectors {
+		full, err := ExpandSignature(vector.compact)
+		if err != nil {
+			t.Fatalf("ExpandSignature: %v", err)
+		}
+		if full != vector.full {
+			t.Errorf("ExpandSignature(%q) = %s, want %s", vector.message, full, vector.full)
+		}
+	}
+
+	if _, err := ExpandSignature(eip2098Vectors[0].full); err == nil {
+		t.Error("expected an error for a 65-byte signature")
+	}
+	if _, err := ExpandSignature("0xzz"); err == nil {
+		t.Error("expected an error for a non-hex signature")
+	}
+}
+
+func TestVerifyCompactSignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	address, err := sv.GetAddressFromPrivateKey(eip2098Key)
+	if err != nil {
+		t.Fatalf("GetAddressFromPrivateKey: %v", err)
+	}
+
+	for _, vector := range eip2098Vectors {
+		for _, signature := range []string{vector.full, vector.compact} {
+			valid, err := sv.VerifyPersonal(vector.message, signature, address)
+			if err != nil || !valid {
+				t.Errorf("VerifyPersonal(%q, %s): valid = %v, err = %v", vector.message, signature, valid, err)
+			}
+		}
+	}
+
+	// A compact signature with the wrong parity recovers another address
+	flipped := eip2098Vectors[0].compact[:66] + "f" + eip2098Vectors[0].compact[67:]
+	valid, err := sv.VerifyPersonal(eip2098Vectors[0].message, flipped, address)
+	if err != nil {
+		t.Fatalf("VerifyPersonal: %v", err)
+	}
+	if valid {
+		t.Error("signature with the wrong y-parity should not verify")
+	}
+}
@@ -0,0 +1,94 @@
+package sigverify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// erc1271ABI is the EIP-1271 signature validation interface
+const erc1271ABI = `[
+	{"type":"function","name":"isValidSignature","stateMutability":"view",
+	 "inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],
+	 "outputs":[{"name":"magicValue","type":"bytes4"}]}
+]`
+
+// erc1271MagicValue is returned by isValidSignature for a valid signature
+var erc1271MagicValue = []byte{0x16, 0x26, 0xba, 0x7e}
+
+// VerifyContractSignature verifies a signature by address, which may be a
+// smart-contract wallet (Safe, Argent). If address has code on the chain
+// served by rpcURL, its EIP-1271 isValidSignature is called with the
+// message hash under the verifier's scheme; otherwise the signature is
+// checked by EOA recovery like VerifySignature.
+func (sv *SignatureVerifier) VerifyContractSignature(ctx context.Context, rpcURL, message, signature, address string) (bool, error) {
+	if !common.IsHexAddress(address) {
+		return false, fmt.Errorf("invalid address %q", address)
+	}
+	contract := common.HexToAddress(address)
+	hash := sv.Scheme.hash(message)
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+
+	code, err := client.CodeAt(ctx, contract, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get code at %s: %w", address, err)
+	}
+	if len(code) == 0 {
+		return verifyHash(hash, signature, address)
+	}
+
+	// Contract wallets define their own signature format, so any length
+	// is passed through
+	sigBytes, err := hexutil.Decode(signature)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(erc1271ABI))
+	if err != nil {
+		return false, fmt.Errorf("failed to parse EIP-1271 ABI: %w", err)
+	}
+	input, err := parsed.Pack("isValidSignature", common.BytesToHash(hash), sigBytes)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode isValidSignature call: %w", err)
+	}
+
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: input}, nil)
+	if isExecutionReverted(err) {
+		// Some wallets, e.g. Safe, revert on an invalid signature
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("isValidSignature call failed: %w", err)
+	}
+
+	// A wallet that does not implement EIP-1271 returns nothing or junk
+	return len(output) >= len(erc1271MagicValue) && bytes.Equal(output[:len(erc1271MagicValue)], erc1271MagicValue), nil
+}
+
+// isExecutionReverted reports whether err is an eth_call execution revert:
+// JSON-RPC error code 3, or an "execution reverted" message from nodes
+// that revert without a reason under the generic -32000 code. Any other
+// error, such as a node or transport failure, is not a verdict on the
+// signature.
+func isExecutionReverted(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return rpcErr.ErrorCode() == 3 || strings.HasPrefix(rpcErr.Error(), "execution reverted")
+}
@@ -0,0 +1,124 @@
+package sigverify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	validWallet      = "0x1111111111111111111111111111111111111111"
+	invalidWallet    = "0x2222222222222222222222222222222222222222"
+	revertingWallet  = "0x3333333333333333333333333333333333333333"
+	bareRevertWallet = "0x5555555555555555555555555555555555555555"
+	brokenWallet     = "0x6666666666666666666666666666666666666666"
+)
+
+// newWalletRPC serves eth_getCode and eth_call for contract wallets that
+// accept every signature, reject them, revert (with code 3, or -32000 and no
+// reason) or hit a node-side failure. Every other address is an EOA.
+func newWalletRPC(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_getCode":
+			var address string
+			json.Unmarshal(req.Params[0], &address)
+			switch strings.ToLower(address) {
+			case validWallet, invalidWallet, revertingWallet, bareRevertWallet, brokenWallet:
+				resp["result"] = "0x6080"
+			default:
+				resp["result"] = "0x"
+			}
+		case "eth_call":
+			var call struct {
+				To string `json:"to"`
+			}
+			json.Unmarshal(req.Params[0], &call)
+			switch strings.ToLower(call.To) {
+			case validWallet:
+				resp["result"] = "0x1626ba7e" + strings.Repeat("0", 56)
+			case invalidWallet:
+				resp["result"] = "0xffffffff" + strings.Repeat("0", 56)
+			case bareRevertWallet:
+				resp["error"] = map[string]interface{}{"code": -32000, "message": "execution reverted"}
+			case brokenWallet:
+				resp["error"] = map[string]interface{}{"code": -32000, "message": "missing trie node", "data": "0xdead"}
+			default:
+				resp["error"] = map[string]interface{}{"code": 3, "message": "execution reverted: GS026", "data": "0x"}
+			}
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVerifyContractSignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+	server := newWalletRPC(t)
+	ctx := context.Background()
+	message := "Hello, Ethereum!"
+
+	signature, err := sv.SignMessage(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		address string
+		want    bool
+	}{
+		{"EOA signer", testAddress, true},
+		{"EOA non-signer", "0x4444444444444444444444444444444444444444", false},
+		{"wallet accepting", validWallet, true},
+		{"wallet rejecting", invalidWallet, false},
+		{"wallet reverting", revertingWallet, false},
+		{"wallet reverting without reason", bareRevertWallet, false},
+	}
+	for _, tt := range tests {
+		valid, err := sv.VerifyContractSignature(ctx, server.URL, message, signature, tt.address)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if valid != tt.want {
+			t.Errorf("%s: valid = %v, want %v", tt.name, valid, tt.want)
+		}
+	}
+
+	// Contract wallets accept signatures of any length
+	valid, err := sv.VerifyContractSignature(ctx, server.URL, message, "0x01", validWallet)
+	if err != nil || !valid {
+		t.Errorf("short wallet signature: valid = %v, err = %v", valid, err)
+	}
+
+	// A node failure is an error, not an invalid signature
+	if _, err := sv.VerifyContractSignature(ctx, server.URL, message, signature, brokenWallet); err == nil {
+		t.Error("expected an error for a node-side failure")
+	}
+
+	if _, err := sv.VerifyContractSignature(ctx, server.URL, message, signature, "not an address"); err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+	if _, err := sv.VerifyContractSignature(ctx, server.URL, message, "0xzz", validWallet); err == nil {
+		t.Error("expected an error for a non-hex signature")
+	}
+}
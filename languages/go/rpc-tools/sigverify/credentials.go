@@ -0,0 +1,208 @@
+package sigverify
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// VerifiedCredential is the result of verifying a JWT Verifiable Credential
+type VerifiedCredential struct {
+	Issuer    string // issuer DID
+	Subject   string // credentialSubject.id
+	Types     []string
+	Claims    map[string]interface{}
+	IssuedAt  time.Time
+	ExpiresAt time.Time // zero if the credential does not expire
+	Hash      string    // sha256 of the compact JWT, the on-chain attestation reference
+}
+
+// jwtHeader is the JOSE header of a vc-jwt
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+// jwtVCClaims are the registered claims of a vc-jwt (W3C VC Data Model 1.1, section 6.3.1)
+type jwtVCClaims struct {
+	Iss string `json:"iss"`
+	Sub string `json:"sub"`
+	Nbf int64  `json:"nbf"`
+	Iat int64  `json:"iat"`
+	Exp int64  `json:"exp"`
+	VC  struct {
+		Type              []string               `json:"type"`
+		CredentialSubject map[string]interface{} `json:"credentialSubject"`
+	} `json:"vc"`
+}
+
+// VerifyCredentialJWT verifies a W3C Verifiable Credential encoded as a JWT
+// signed with ES256K or ES256K-R by a did:ethr or did:pkh (eip155) issuer.
+// The issuer must be one of trustedIssuers; anyone can self-issue a
+// credential for their own DID, so an empty list accepts nothing.
+func (sv *SignatureVerifier) VerifyCredentialJWT(token string, trustedIssuers []string, now time.Time) (*VerifiedCredential, error) {
+	if len(trustedIssuers) == 0 {
+		return nil, errors.New("no trusted issuers")
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("credential is not a compact JWT")
+	}
+
+	// Decode header and claims
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("failed to decode header: %w", err)
+	}
+	if header.Alg != "ES256K" && header.Alg != "ES256K-R" {
+		return nil, fmt.Errorf("unsupported alg %q", header.Alg)
+	}
+
+	var claims jwtVCClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("failed to decode claims: %w", err)
+	}
+
+	if !containsString(claims.VC.Type, "VerifiableCredential") {
+		return nil, errors.New("token is not a VerifiableCredential")
+	}
+
+	if !containsString(trustedIssuers, claims.Iss) {
+		return nil, fmt.Errorf("issuer %s is not trusted", claims.Iss)
+	}
+
+	// Check validity period
+	if claims.Nbf != 0 && now.Before(time.Unix(claims.Nbf, 0)) {
+		return nil, errors.New("credential not yet valid")
+	}
+	if claims.Exp != 0 && !now.Before(time.Unix(claims.Exp, 0)) {
+		return nil, errors.New("credential expired")
+	}
+
+	// Verify the issuer signature over "header.payload"
+	issuerAddress, err := addressFromDID(claims.Iss)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if !jwsSignedBy(digest[:], sig, issuerAddress) {
+		return nil, errors.New("credential signature does not match issuer")
+	}
+
+	subject := claims.Sub
+	if id, ok := claims.VC.CredentialSubject["id"].(string); ok && id != "" {
+		if subject != "" && subject != id {
+			return nil, errors.New("sub does not match credentialSubject.id")
+		}
+		subject = id
+	}
+
+	tokenHash := sha256.Sum256([]byte(token))
+	vc := &VerifiedCredential{
+		Issuer:  claims.Iss,
+		Subject: subject,
+		Types:   claims.VC.Type,
+		Claims:  claims.VC.CredentialSubject,
+		Hash:    hex.EncodeToString(tokenHash[:]),
+	}
+	if claims.Iat != 0 {
+		vc.IssuedAt = time.Unix(claims.Iat, 0)
+	} else if claims.Nbf != 0 {
+		vc.IssuedAt = time.Unix(claims.Nbf, 0)
+	}
+	if claims.Exp != 0 {
+		vc.ExpiresAt = time.Unix(claims.Exp, 0)
+	}
+
+	return vc, nil
+}
+
+// jwsSignedBy checks an ES256K (r||s) or ES256K-R (r||s||v) signature
+// against an Ethereum address by public key recovery
+func jwsSignedBy(digest, sig []byte, expected common.Address) bool {
+	var candidates [][]byte
+	switch len(sig) {
+	case 64:
+		for v := byte(0); v < 2; v++ {
+			candidates = append(candidates, append(append([]byte{}, sig...), v))
+		}
+	case 65:
+		candidate := append([]byte{}, sig...)
+		if candidate[64] >= 27 {
+			candidate[64] -= 27
+		}
+		candidates = append(candidates, candidate)
+	default:
+		return false
+	}
+
+	for _, candidate := range candidates {
+		pubKey, err := crypto.SigToPub(digest, candidate)
+		if err == nil && crypto.PubkeyToAddress(*pubKey) == expected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addressFromDID extracts the Ethereum address from did:ethr and did:pkh:eip155 identifiers
+func addressFromDID(did string) (common.Address, error) {
+	parts := strings.Split(did, ":")
+	if len(parts) < 3 || parts[0] != "did" {
+		return common.Address{}, fmt.Errorf("invalid DID %q", did)
+	}
+
+	var account string
+	switch parts[1] {
+	case "ethr":
+		// did:ethr:<address> or did:ethr:<network>:<address>
+		account = parts[len(parts)-1]
+	case "pkh":
+		// did:pkh:eip155:<chainId>:<address>
+		if len(parts) != 5 || parts[2] != "eip155" {
+			return common.Address{}, fmt.Errorf("unsupported did:pkh namespace in %q", did)
+		}
+		account = parts[4]
+	default:
+		return common.Address{}, fmt.Errorf("unsupported DID method %q", parts[1])
+	}
+
+	if !common.IsHexAddress(account) {
+		return common.Address{}, fmt.Errorf("DID %q does not carry an Ethereum address", did)
+	}
+
+	return common.HexToAddress(account), nil
+}
+
+func decodeJWTPart(part string, out interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package sigverify
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	testIssuer     = "did:ethr:" + testAddress
+	credentialNow  = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	credentialTerm = 24 * time.Hour
+)
+
+// signCredential builds a vc-jwt for subject, signed by testPrivateKey with alg
+func signCredential(t *testing.T, alg, issuer, subject string, exp time.Time) string {
+	t.Helper()
+
+	header, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss": issuer,
+		"sub": subject,
+		"nbf": credentialNow.Add(-time.Hour).Unix(),
+		"exp": exp.Unix(),
+		"vc": map[string]interface{}{
+			"type":              []string{"VerifiableCredential", "DonorCredential"},
+			"credentialSubject": map[string]interface{}{"id": subject, "tier": "gold"},
+		},
+	})
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(testPrivateKey, "0x"))
+	if err != nil {
+		t.Fatalf("HexToECDSA: %v", err)
+	}
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := crypto.Sign(digest[:], key)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if alg == "ES256K" {
+		sig = sig[:64]
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestVerifyCredentialJWT(t *testing.T) {
+	sv := NewSignatureVerifier()
+	subject := "did:pkh:eip155:1:0x742d35Cc6634C0532925a3b844Bc454e4438f44e"
+
+	for _, alg := range []string{"ES256K", "ES256K-R"} {
+		token := signCredential(t, alg, testIssuer, subject, credentialNow.Add(credentialTerm))
+
+		vc, err := sv.VerifyCredentialJWT(token, []string{testIssuer}, credentialNow)
+		if err != nil {
+			t.Fatalf("%s: VerifyCredentialJWT: %v", alg, err)
+		}
+		if vc.Issuer != testIssuer || vc.Subject != subject || vc.Claims["tier"] != "gold" {
+			t.Errorf("%s: unexpected credential %+v", alg, vc)
+		}
+		if !vc.ExpiresAt.Equal(credentialNow.Add(credentialTerm)) {
+			t.Errorf("%s: ExpiresAt = %v", alg, vc.ExpiresAt)
+		}
+		if len(vc.Hash) != 64 {
+			t.Errorf("%s: Hash = %q", alg, vc.Hash)
+		}
+	}
+}
+
+func TestVerifyCredentialJWTRejects(t *testing.T) {
+	sv := NewSignatureVerifier()
+	subject := "did:ethr:0x742d35Cc6634C0532925a3b844Bc454e4438f44e"
+	valid := signCredential(t, "ES256K", testIssuer, subject, credentialNow.Add(credentialTerm))
+
+	otherIssuer := "did:ethr:0x742d35Cc6634C0532925a3b844Bc454e4438f44e"
+
+	// Claims naming another issuer under testIssuer's signature
+	parts := strings.Split(valid, ".")
+	forgedClaims, _ := json.Marshal(map[string]interface{}{
+		"iss": otherIssuer,
+		"sub": subject,
+		"vc":  map[string]interface{}{"type": []string{"VerifiableCredential"}},
+	})
+	forged := parts[0] + "." + base64.RawURLEncoding.EncodeToString(forgedClaims) + "." + parts[2]
+
+	tests := []struct {
+		name    string
+		token   string
+		trusted []string
+		want    string
+	}{
+		{"no trusted issuers", valid, nil, "no trusted issuers"},
+		{"issuer mismatch", valid, []string{otherIssuer}, "not trusted"},
+		{"expired", signCredential(t, "ES256K-R", testIssuer, subject, credentialNow), []string{testIssuer}, "expired"},
+		{"wrong signer", forged, []string{otherIssuer}, "does not match issuer"},
+		{"unsupported alg", signCredential(t, "ES256", testIssuer, subject, credentialNow.Add(credentialTerm)), []string{testIssuer}, "unsupported alg"},
+		{"malformed", "not-a-jwt", []string{testIssuer}, "compact JWT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sv.VerifyCredentialJWT(tt.token, tt.trusted, credentialNow)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("VerifyCredentialJWT error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
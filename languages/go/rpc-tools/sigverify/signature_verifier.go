@@ -0,0 +1,244 @@
+// Package sigverify signs and verifies Ethereum messages and manages the
+// keys behind them.
+package sigverify
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// HashScheme selects how a message is hashed before it is signed
+type HashScheme int
+
+const (
+	// HashRaw signs the Keccak256 hash of the message itself
+	HashRaw HashScheme = iota
+	// HashPersonal signs the EIP-191 personal_sign hash of the message,
+	// Keccak256("\x19Ethereum Signed Message:\n" + len(message) + message),
+	// as produced by MetaMask and other wallets
+	HashPersonal
+)
+
+// SignatureVerifier handles Ethereum signature verification
+type SignatureVerifier struct {
+	// Scheme is the hashing scheme used by SignMessage, VerifySignature
+	// and HashMessage. The zero value is HashRaw.
+	Scheme HashScheme
+
+	// BatchWorkers is the number of goroutines VerifyBatch verifies on;
+	// 0 means GOMAXPROCS
+	BatchWorkers int
+}
+
+// NewSignatureVerifier creates a new signature verifier instance
+func NewSignatureVerifier() *SignatureVerifier {
+	return &SignatureVerifier{}
+}
+
+// NewSignatureVerifierWithScheme creates a signature verifier that hashes
+// messages with scheme
+func NewSignatureVerifierWithScheme(scheme HashScheme) *SignatureVerifier {
+	return &SignatureVerifier{Scheme: scheme}
+}
+
+// hash returns the hash of message under scheme
+func (scheme HashScheme) hash(message string) []byte {
+	if scheme == HashPersonal {
+		return accounts.TextHash([]byte(message))
+	}
+	return crypto.Keccak256([]byte(message))
+}
+
+// VerifySignature verifies an Ethereum signature, either 65 bytes or
+// 64-byte EIP-2098 compact
+func (sv *SignatureVerifier) VerifySignature(message, signature, address string) (bool, error) {
+	return verifyHash(sv.Scheme.hash(message), signature, address)
+}
+
+// VerifyPersonal verifies an EIP-191 personal_sign signature, regardless
+// of the verifier's scheme
+func (sv *SignatureVerifier) VerifyPersonal(message, signature, address string) (bool, error) {
+	return verifyHash(HashPersonal.hash(message), signature, address)
+}
+
+// verifyHash checks that signature over hash was made by address
+func verifyHash(hash []byte, signature, address string) (bool, error) {
+	recoveredAddress, err := recoverHash(hash, signature)
+	if err != nil {
+		return false, err
+	}
+
+	// Compare addresses
+	expectedAddress := common.HexToAddress(address)
+
+	return recoveredAddress == expectedAddress, nil
+}
+
+// RecoverAddress returns the checksummed address that signed message
+// under the verifier's scheme. The signature may be 65 bytes with V of 0,
+// 1, 27 or 28, or 64-byte EIP-2098 compact.
+func (sv *SignatureVerifier) RecoverAddress(message, signature string) (string, error) {
+	address, err := recoverHash(sv.Scheme.hash(message), signature)
+	if err != nil {
+		return "", err
+	}
+	return address.Hex(), nil
+}
+
+// recoverHash returns the address that made signature over hash
+func recoverHash(hash []byte, signature string) (common.Address, error) {
+	// Decode signature
+	sigBytes, err := hexutil.Decode(signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	sigBytes, err = normalizeSignature(sigBytes)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// Recover public key
+	pubKey, err := crypto.SigToPub(hash, sigBytes)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover public key: %w", err)
+	}
+
+	// Get address from public key
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// normalizeSignature returns a copy of sig as 65 bytes with V of 0 or 1,
+// the form crypto.SigToPub expects. sig may be 65 bytes with V of 0, 1, 27
+// or 28, or 64-byte EIP-2098 compact; it is never modified.
+func normalizeSignature(sig []byte) ([]byte, error) {
+	var normalized []byte
+	switch len(sig) {
+	case 65:
+		normalized = append([]byte(nil), sig...)
+	case 64:
+		normalized = fromCompact(sig)
+	default:
+		return nil, errors.New("invalid signature length")
+	}
+
+	switch v := normalized[64]; v {
+	case 0, 1:
+	case 27, 28:
+		normalized[64] = v - 27
+	default:
+		return nil, fmt.Errorf("invalid recovery id %d", v)
+	}
+
+	return normalized, nil
+}
+
+// SignMessage signs a message with a private key
+func (sv *SignatureVerifier) SignMessage(message string, privateKeyHex string) (string, error) {
+	return signHash(sv.Scheme.hash(message), privateKeyHex)
+}
+
+// SignPersonal signs a message the way personal_sign does (EIP-191),
+// regardless of the verifier's scheme
+func (sv *SignatureVerifier) SignPersonal(message string, privateKeyHex string) (string, error) {
+	return signHash(HashPersonal.hash(message), privateKeyHex)
+}
+
+// signHash signs hash with a hex private key, returning a 65-byte
+// signature with V of 27 or 28
+func signHash(hash []byte, privateKeyHex string) (string, error) {
+	privateKey, err := parsePrivateKey(privateKeyHex)
+	if err != nil {
+		return "", err
+	}
+
+	// Sign the hash
+	signature, err := crypto.Sign(hash, privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	// Adjust V value for Ethereum
+	signature[64] += 27
+
+	return hexutil.Encode(signature), nil
+}
+
+// GetAddressFromPrivateKey derives Ethereum address from private key
+func (sv *SignatureVerifier) GetAddressFromPrivateKey(privateKeyHex string) (string, error) {
+	privateKey, err := parsePrivateKey(privateKeyHex)
+	if err != nil {
+		return "", err
+	}
+
+	// Get public key
+	publicKey := privateKey.Public()
+	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return "", errors.New("failed to cast public key to ECDSA")
+	}
+
+	// Get address
+	address := crypto.PubkeyToAddress(*publicKeyECDSA)
+
+	return address.Hex(), nil
+}
+
+// GeneratePrivateKey generates a new Ethereum private key
+func (sv *SignatureVerifier) GeneratePrivateKey() (privateKey string, address string, err error) {
+	// Generate new private key
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	// Get private key bytes
+	privateKeyBytes := crypto.FromECDSA(key)
+	privateKeyHex := hexutil.Encode(privateKeyBytes)
+
+	// Get address
+	publicKey := key.Public()
+	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return "", "", errors.New("failed to cast public key to ECDSA")
+	}
+
+	addressHex := crypto.PubkeyToAddress(*publicKeyECDSA).Hex()
+
+	return privateKeyHex, addressHex, nil
+}
+
+// HashMessage returns the hash of a message under the verifier's scheme,
+// Keccak256 by default
+func (sv *SignatureVerifier) HashMessage(message string) string {
+	return hexutil.Encode(sv.Scheme.hash(message))
+}
+
+// parsePrivateKey decodes a hex private key, with or without 0x prefix
+func parsePrivateKey(privateKeyHex string) (*ecdsa.PrivateKey, error) {
+	// Remove 0x prefix if present
+	if len(privateKeyHex) > 2 && privateKeyHex[:2] == "0x" {
+		privateKeyHex = privateKeyHex[2:]
+	}
+
+	// Decode private key
+	privateKeyBytes, err := hex.DecodeString(privateKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+
+	// Create ECDSA private key
+	privateKey, err := crypto.ToECDSA(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create private key: %w", err)
+	}
+
+	return privateKey, nil
+}
@@ -0,0 +1,320 @@
+package sigverify
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	testPrivateKey = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress    = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+)
+
+func TestNewSignatureVerifier(t *testing.T) {
+	if NewSignatureVerifier() == nil {
+		t.Fatal("NewSignatureVerifier returned nil")
+	}
+}
+
+func TestGetAddressFromPrivateKey(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	for _, key := range []string{testPrivateKey, strings.TrimPrefix(testPrivateKey, "0x")} {
+		address, err := sv.GetAddressFromPrivateKey(key)
+		if err != nil {
+			t.Fatalf("GetAddressFromPrivateKey(%q): %v", key, err)
+		}
+		if address != testAddress {
+			t.Errorf("GetAddressFromPrivateKey(%q) = %s, want %s", key, address, testAddress)
+		}
+	}
+
+	if _, err := sv.GetAddressFromPrivateKey("0xzz"); err == nil {
+		t.Error("expected an error for a non-hex key")
+	}
+	if _, err := sv.GetAddressFromPrivateKey("0x01"); err == nil {
+		t.Error("expected an error for a short key")
+	}
+}
+
+func TestGeneratePrivateKey(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	privateKey, address, err := sv.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("GeneratePrivateKey: %v", err)
+	}
+	if len(privateKey) != 66 || !strings.HasPrefix(privateKey, "0x") {
+		t.Errorf("unexpected private key format %q", privateKey)
+	}
+	if len(address) != 42 || !strings.HasPrefix(address, "0x") {
+		t.Errorf("unexpected address format %q", address)
+	}
+
+	derived, err := sv.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("GetAddressFromPrivateKey: %v", err)
+	}
+	if derived != address {
+		t.Errorf("generated address %s does not match derived address %s", address, derived)
+	}
+
+	other, _, err := sv.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("GeneratePrivateKey: %v", err)
+	}
+	if other == privateKey {
+		t.Error("GeneratePrivateKey returned the same key twice")
+	}
+}
+
+func TestSignMessage(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	signature, err := sv.SignMessage("Hello, Ethereum!", testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	if len(signature) != 132 || !strings.HasPrefix(signature, "0x") {
+		t.Fatalf("unexpected signature format %q", signature)
+	}
+	if v := signature[130:]; v != "1b" && v != "1c" {
+		t.Errorf("signature v = 0x%s, want 0x1b or 0x1c", v)
+	}
+
+	// Signatures are deterministic (RFC 6979)
+	again, err := sv.SignMessage("Hello, Ethereum!", strings.TrimPrefix(testPrivateKey, "0x"))
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	if again != signature {
+		t.Error("signing the same message twice gave different signatures")
+	}
+
+	if _, err := sv.SignMessage("Hello, Ethereum!", "not a key"); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	sv := NewSignatureVerifier()
+	message := "Hello, Ethereum!"
+
+	signature, err := sv.SignMessage(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+
+	valid, err := sv.VerifySignature(message, signature, testAddress)
+	if err != nil {
+		t.Fatalf("VerifySignature: %v", err)
+	}
+	if !valid {
+		t.Error("signature should be valid")
+	}
+
+	valid, err = sv.VerifySignature(message, signature, strings.ToLower(testAddress))
+	if err != nil || !valid {
+		t.Errorf("lowercase address: valid = %v, err = %v", valid, err)
+	}
+
+	valid, err = sv.VerifySignature("Hello, Ethereum?", signature, testAddress)
+	if err != nil {
+		t.Fatalf("VerifySignature: %v", err)
+	}
+	if valid {
+		t.Error("signature should not verify for a different message")
+	}
+
+	_, other, err := sv.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("GeneratePrivateKey: %v", err)
+	}
+	valid, err = sv.VerifySignature(message, signature, other)
+	if err != nil {
+		t.Fatalf("VerifySignature: %v", err)
+	}
+	if valid {
+		t.Error("signature should not verify for a different address")
+	}
+
+	if _, err := sv.VerifySignature(message, "0xzz", testAddress); err == nil {
+		t.Error("expected an error for a non-hex signature")
+	}
+	if _, err := sv.VerifySignature(message, signature[:len(signature)-4], testAddress); err == nil {
+		t.Error("expected an error for a short signature")
+	}
+}
+
+func TestHashMessage(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"", "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"hello", "0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8"},
+	}
+	for _, tt := range tests {
+		if got := sv.HashMessage(tt.message); got != tt.want {
+			t.Errorf("HashMessage(%q) = %s, want %s", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestHashMessagePersonal(t *testing.T) {
+	sv := NewSignatureVerifierWithScheme(HashPersonal)
+
+	want := "0x50b2c43fd39106bafbba0da34fc430e1f91e3c96ea2acee2bc34119f92b37750"
+	if got := sv.HashMessage("hello"); got != want {
+		t.Errorf("HashMessage(%q) = %s, want %s", "hello", got, want)
+	}
+}
+
+func TestSignPersonal(t *testing.T) {
+	sv := NewSignatureVerifier()
+	message := "Hello, Ethereum!"
+
+	personal, err := sv.SignPersonal(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignPersonal: %v", err)
+	}
+	raw, err := sv.SignMessage(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	if personal == raw {
+		t.Error("personal_sign signature should differ from the raw signature")
+	}
+
+	// A verifier using the personal scheme signs the same way
+	scheme, err := NewSignatureVerifierWithScheme(HashPersonal).SignMessage(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	if scheme != personal {
+		t.Error("SignMessage with HashPersonal should match SignPersonal")
+	}
+
+	if _, err := sv.SignPersonal(message, "not a key"); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
+
+func TestVerifyPersonal(t *testing.T) {
+	sv := NewSignatureVerifier()
+	message := "Hello, Ethereum!"
+
+	signature, err := sv.SignPersonal(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignPersonal: %v", err)
+	}
+
+	valid, err := sv.VerifyPersonal(message, signature, testAddress)
+	if err != nil || !valid {
+		t.Fatalf("VerifyPersonal: valid = %v, err = %v", valid, err)
+	}
+
+	// The raw scheme does not accept a personal_sign signature
+	valid, err = sv.VerifySignature(message, signature, testAddress)
+	if err != nil {
+		t.Fatalf("VerifySignature: %v", err)
+	}
+	if valid {
+		t.Error("raw verification should reject a personal_sign signature")
+	}
+
+	valid, err = NewSignatureVerifierWithScheme(HashPersonal).VerifySignature(message, signature, testAddress)
+	if err != nil || !valid {
+		t.Errorf("VerifySignature with HashPersonal: valid = %v, err = %v", valid, err)
+	}
+
+	raw, err := sv.SignMessage(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	valid, err = sv.VerifyPersonal(message, raw, testAddress)
+	if err != nil {
+		t.Fatalf("VerifyPersonal: %v", err)
+	}
+	if valid {
+		t.Error("VerifyPersonal should reject a raw signature")
+	}
+}
+
+func TestRecoverAddress(t *testing.T) {
+	sv := NewSignatureVerifier()
+	message := "Hello, Ethereum!"
+
+	signature, err := sv.SignMessage(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	compact, err := CompactSignature(signature)
+	if err != nil {
+		t.Fatalf("CompactSignature: %v", err)
+	}
+	v := signature[130:]
+	zeroV := signature[:130] + map[string]string{"1b": "00", "1c": "01"}[v]
+
+	for _, sig := range []string{signature, zeroV, compact} {
+		address, err := sv.RecoverAddress(message, sig)
+		if err != nil {
+			t.Fatalf("RecoverAddress(%s): %v", sig, err)
+		}
+		if address != testAddress {
+			t.Errorf("RecoverAddress(%s) = %s, want %s", sig, address, testAddress)
+		}
+	}
+
+	// The verifier's scheme selects the hash
+	personal, err := sv.SignPersonal(message, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignPersonal: %v", err)
+	}
+	address, err := NewSignatureVerifierWithScheme(HashPersonal).RecoverAddress(message, personal)
+	if err != nil || address != testAddress {
+		t.Errorf("RecoverAddress with HashPersonal = %s, %v, want %s", address, err, testAddress)
+	}
+
+	for _, badV := range []string{"02", "1d", "25"} {
+		if _, err := sv.RecoverAddress(message, signature[:130]+badV); err == nil {
+			t.Errorf("expected an error for v = 0x%s", badV)
+		}
+	}
+	if _, err := sv.RecoverAddress(message, "0x1234"); err == nil {
+		t.Error("expected an error for a short signature")
+	}
+}
+
+func TestNormalizeSignatureDoesNotMutate(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	signature, err := sv.SignMessage("Hello, Ethereum!", testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	sig := hexutil.MustDecode(signature)
+	original := append([]byte(nil), sig...)
+
+	normalized, err := normalizeSignature(sig)
+	if err != nil {
+		t.Fatalf("normalizeSignature: %v", err)
+	}
+	if !bytes.Equal(sig, original) {
+		t.Error("normalizeSignature modified its input")
+	}
+	if normalized[64] != original[64]-27 {
+		t.Errorf("normalized v = %d, want %d", normalized[64], original[64]-27)
+	}
+
+	// Normalizing again (v already 0 or 1) is a no-op
+	again, err := normalizeSignature(normalized)
+	if err != nil || !bytes.Equal(again, normalized) {
+		t.Errorf("normalizeSignature is not idempotent: %x, %v", again, err)
+	}
+}
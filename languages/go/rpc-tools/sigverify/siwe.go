@@ -0,0 +1,290 @@
+package sigverify
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// siweHeader ends the first line of a Sign-In With Ethereum message
+const siweHeader = " wants you to sign in with your Ethereum account:"
+
+// siweNonceAlphabet is the character set of SIWE nonces
+const siweNonceAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// SIWEMessage is a Sign-In With Ethereum (EIP-4361) message
+type SIWEMessage struct {
+	Scheme         string // optional URI scheme of the requesting origin
+	Domain         string // RFC 3986 authority requesting the signing
+	Address        string // EIP-55 checksummed signer address
+	Statement      string // optional human-readable assertion
+	URI            string
+	Version        string // always "1"
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time // zero if the message does not expire
+	NotBefore      time.Time // zero if the message is valid immediately
+	RequestID      string
+	Resources      []string
+}
+
+// ParseSIWEMessage parses an EIP-4361 message as presented to the wallet
+func ParseSIWEMessage(text string) (*SIWEMessage, error) {
+	lines := strings.Split(text, "\n")
+	next := func() (string, bool) {
+		if len(lines) == 0 {
+			return "", false
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, true
+	}
+
+	// Header and address
+	header, _ := next()
+	origin, ok := strings.CutSuffix(header, siweHeader)
+	if !ok || origin == "" {
+		return nil, errors.New("missing SIWE header")
+	}
+	m := &SIWEMessage{Domain: origin}
+	if scheme, domain, ok := strings.Cut(origin, "://"); ok {
+		m.Scheme, m.Domain = scheme, domain
+	}
+
+	address, _ := next()
+	if !common.IsHexAddress(address) || !strings.HasPrefix(address, "0x") {
+		return nil, fmt.Errorf("invalid address %q", address)
+	}
+	if common.HexToAddress(address).Hex() != address {
+		return nil, fmt.Errorf("address %s is not EIP-55 checksummed", address)
+	}
+	m.Address = address
+
+	// Optional statement, surrounded by blank lines
+	if line, _ := next(); line != "" {
+		return nil, errors.New("expected a blank line after the address")
+	}
+	line, _ := next()
+	if line != "" {
+		if strings.HasPrefix(line, "URI: ") {
+			return nil, errors.New("expected a statement or a blank line")
+		}
+		m.Statement = line
+		if line, _ = next(); line != "" {
+			return nil, errors.New("expected a blank line after the statement")
+		}
+	}
+
+	// Fields, in the order the specification defines them
+	field := func(name string, required bool) (string, error) {
+		if len(lines) > 0 {
+			if value, ok := strings.CutPrefix(lines[0], name+": "); ok {
+				lines = lines[1:]
+				return value, nil
+			}
+		}
+		if required {
+			return "", fmt.Errorf("missing %s", name)
+		}
+		return "", nil
+	}
+	timeField := func(name string, required bool) (time.Time, error) {
+		value, err := field(name, required)
+		if err != nil || value == "" {
+			return time.Time{}, err
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		return t, nil
+	}
+
+	var err error
+	if m.URI, err = field("URI", true); err != nil {
+		return nil, err
+	}
+	if m.Version, err = field("Version", true); err != nil {
+		return nil, err
+	}
+	if m.Version != "1" {
+		return nil, fmt.Errorf("unsupported version %q", m.Version)
+	}
+
+	chainID, err := field("Chain ID", true)
+	if err != nil {
+		return nil, err
+	}
+	if m.ChainID, err = strconv.ParseInt(chainID, 10, 64); err != nil || m.ChainID <= 0 {
+		return nil, fmt.Errorf("invalid Chain ID %q", chainID)
+	}
+
+	if m.Nonce, err = field("Nonce", true); err != nil {
+		return nil, err
+	}
+	if !validSIWENonce(m.Nonce) {
+		return nil, fmt.Errorf("invalid nonce %q: need at least 8 alphanumeric characters", m.Nonce)
+	}
+
+	if m.IssuedAt, err = timeField("Issued At", true); err != nil {
+		return nil, err
+	}
+	if m.ExpirationTime, err = timeField("Expiration Time", false); err != nil {
+		return nil, err
+	}
+	if m.NotBefore, err = timeField("Not Before", false); err != nil {
+		return nil, err
+	}
+	if m.RequestID, err = field("Request ID", false); err != nil {
+		return nil, err
+	}
+
+	if len(lines) > 0 && lines[0] == "Resources:" {
+		lines = lines[1:]
+		for len(lines) > 0 && strings.HasPrefix(lines[0], "- ") {
+			m.Resources = append(m.Resources, strings.TrimPrefix(lines[0], "- "))
+			lines = lines[1:]
+		}
+	}
+
+	if len(lines) > 0 {
+		return nil, fmt.Errorf("unexpected line %q", lines[0])
+	}
+
+	return m, nil
+}
+
+// String returns the message in EIP-4361 format, the text that is signed
+func (m *SIWEMessage) String() string {
+	var b strings.Builder
+
+	if m.Scheme != "" {
+		b.WriteString(m.Scheme + "://")
+	}
+	b.WriteString(m.Domain + siweHeader + "\n")
+	b.WriteString(m.Address + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+
+	fmt.Fprintf(&b, "URI: %s\n", m.URI)
+	fmt.Fprintf(&b, "Version: %s\n", m.Version)
+	fmt.Fprintf(&b, "Chain ID: %d\n", m.ChainID)
+	fmt.Fprintf(&b, "Nonce: %s\n", m.Nonce)
+	fmt.Fprintf(&b, "Issued At: %s", m.IssuedAt.Format(time.RFC3339))
+	if !m.ExpirationTime.IsZero() {
+		fmt.Fprintf(&b, "\nExpiration Time: %s", m.ExpirationTime.Format(time.RFC3339))
+	}
+	if !m.NotBefore.IsZero() {
+		fmt.Fprintf(&b, "\nNot Before: %s", m.NotBefore.Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		fmt.Fprintf(&b, "\nRequest ID: %s", m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+
+	return b.String()
+}
+
+// SIWEExpectations are the values a server requires of a SIWE message
+type SIWEExpectations struct {
+	Domain  string // the server's own domain
+	Nonce   string // the nonce handed out for this sign-in
+	ChainID int64  // the chain the session is for; required
+	URI     string // optional; empty accepts any URI
+}
+
+// Validate checks that the message was issued for the expected domain,
+// chain and (if set) URI with the nonce the server handed out, and that it
+// is valid at now
+func (m *SIWEMessage) Validate(expected SIWEExpectations, now time.Time) error {
+	if m.Domain != expected.Domain {
+		return fmt.Errorf("message is for domain %s, not %s", m.Domain, expected.Domain)
+	}
+	if m.Nonce != expected.Nonce {
+		return errors.New("nonce does not match")
+	}
+	if expected.ChainID <= 0 {
+		return errors.New("expected chain ID required")
+	}
+	if m.ChainID != expected.ChainID {
+		return fmt.Errorf("message is for chain %d, not %d", m.ChainID, expected.ChainID)
+	}
+	if expected.URI != "" && m.URI != expected.URI {
+		return fmt.Errorf("message is for URI %s, not %s", m.URI, expected.URI)
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return errors.New("message not yet valid")
+	}
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return errors.New("message expired")
+	}
+	return nil
+}
+
+// VerifySIWE parses a signed Sign-In With Ethereum message, validates it
+// against the expected values at now, and checks that it was signed (with
+// personal_sign) by the address it names. On success the parsed message is
+// returned; its Address is the authenticated account.
+func (sv *SignatureVerifier) VerifySIWE(message, signature string, expected SIWEExpectations, now time.Time) (*SIWEMessage, error) {
+	m, err := ParseSIWEMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SIWE message: %w", err)
+	}
+
+	if err := m.Validate(expected, now); err != nil {
+		return nil, err
+	}
+
+	valid, err := sv.VerifyPersonal(message, signature, m.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errors.New("signature does not match message address")
+	}
+
+	return m, nil
+}
+
+// GenerateSIWENonce returns a random 16-character alphanumeric nonce for a
+// sign-in attempt
+func GenerateSIWENonce() (string, error) {
+	nonce := make([]byte, 16)
+	max := big.NewInt(int64(len(siweNonceAlphabet)))
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate nonce: %w", err)
+		}
+		nonce[i] = siweNonceAlphabet[n.Int64()]
+	}
+	return string(nonce), nil
+}
+
+// validSIWENonce reports whether nonce is at least 8 alphanumeric
+// characters
+func validSIWENonce(nonce string) bool {
+	if len(nonce) < 8 {
+		return false
+	}
+	for _, c := range nonce {
+		if !strings.ContainsRune(siweNonceAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,226 @@
+package sigverify
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// siweExample is the example message from EIP-4361, signed by testAddress
+const siweExample = `service.org wants you to sign in with your Ethereum account:
+0x2c7536E3605D9C16a7a3D7b1898e529396a65c23
+
+I accept the ServiceOrg Terms of Service: https://service.org/tos
+
+URI: https://service.org/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Expiration Time: 2021-10-01T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+var siweNow = time.Date(2021, 9, 30, 17, 0, 0, 0, time.UTC)
+
+var siweExpected = SIWEExpectations{Domain: "service.org", Nonce: "32891756", ChainID: 1}
+
+func TestParseSIWEMessage(t *testing.T) {
+	m, err := ParseSIWEMessage(siweExample)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+
+	want := &SIWEMessage{
+		Domain:         "service.org",
+		Address:        testAddress,
+		Statement:      "I accept the ServiceOrg Terms of Service: https://service.org/tos",
+		URI:            "https://service.org/login",
+		Version:        "1",
+		ChainID:        1,
+		Nonce:          "32891756",
+		IssuedAt:       time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC),
+		ExpirationTime: time.Date(2021, 10, 1, 16, 25, 24, 0, time.UTC),
+		Resources: []string{
+			"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+			"https://example.com/my-web2-claim.json",
+		},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("ParseSIWEMessage =\n%+v\nwant\n%+v", m, want)
+	}
+
+	// Optional scheme, no statement, optional fields
+	text := "https://donate.example wants you to sign in with your Ethereum account:\n" +
+		testAddress + "\n\n\n" +
+		"URI: https://donate.example\nVersion: 1\nChain ID: 8453\nNonce: abcdEFGH1234\n" +
+		"Issued At: 2021-09-30T16:25:24Z\nNot Before: 2021-09-30T16:30:00Z\nRequest ID: req-1"
+	m, err = ParseSIWEMessage(text)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+	if m.Scheme != "https" || m.Domain != "donate.example" || m.Statement != "" || m.ChainID != 8453 ||
+		m.NotBefore.IsZero() || m.RequestID != "req-1" || !m.ExpirationTime.IsZero() {
+		t.Errorf("unexpected message %+v", m)
+	}
+}
+
+func TestParseSIWEMessageInvalid(t *testing.T) {
+	tests := map[string]string{
+		"header":         strings.Replace(siweExample, "wants you to sign in", "wants you to log in", 1),
+		"address":        strings.Replace(siweExample, testAddress, "0x1234", 1),
+		"checksum":       strings.Replace(siweExample, testAddress, strings.ToLower(testAddress), 1),
+		"version":        strings.Replace(siweExample, "Version: 1", "Version: 2", 1),
+		"chain id":       strings.Replace(siweExample, "Chain ID: 1", "Chain ID: one", 1),
+		"short nonce":    strings.Replace(siweExample, "Nonce: 32891756", "Nonce: 1234", 1),
+		"nonce charset":  strings.Replace(siweExample, "Nonce: 32891756", "Nonce: 3289-1756", 1),
+		"missing nonce":  strings.Replace(siweExample, "Nonce: 32891756\n", "", 1),
+		"issued at":      strings.Replace(siweExample, "2021-09-30T16:25:24Z", "yesterday", 1),
+		"field order":    strings.Replace(siweExample, "URI: https://service.org/login\nVersion: 1", "Version: 1\nURI: https://service.org/login", 1),
+		"trailing junk":  siweExample + "\nSignature: 0x00",
+		"no blank lines": strings.Replace(siweExample, "\n\n", "\n", -1),
+	}
+	for name, text := range tests {
+		if _, err := ParseSIWEMessage(text); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSIWEMessageString(t *testing.T) {
+	m, err := ParseSIWEMessage(siweExample)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+	if got := m.String(); got != siweExample {
+		t.Errorf("String =\n%s\nwant\n%s", got, siweExample)
+	}
+
+	// Round trip without a statement
+	m.Scheme = "https"
+	m.Statement = ""
+	m.Resources = nil
+	m.NotBefore = m.IssuedAt
+	m.RequestID = "req-1"
+	parsed, err := ParseSIWEMessage(m.String())
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage(String()): %v", err)
+	}
+	if !reflect.DeepEqual(parsed, m) {
+		t.Errorf("round trip =\n%+v\nwant\n%+v", parsed, m)
+	}
+}
+
+func TestSIWEMessageValidate(t *testing.T) {
+	m, err := ParseSIWEMessage(siweExample)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage: %v", err)
+	}
+
+	if err := m.Validate(siweExpected, siweNow); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	withURI := siweExpected
+	withURI.URI = "https://service.org/login"
+	if err := m.Validate(withURI, siweNow); err != nil {
+		t.Errorf("Validate with URI: %v", err)
+	}
+
+	mismatches := map[string]func(e *SIWEExpectations){
+		"domain":   func(e *SIWEExpectations) { e.Domain = "evil.org" },
+		"nonce":    func(e *SIWEExpectations) { e.Nonce = "00000000" },
+		"chain":    func(e *SIWEExpectations) { e.ChainID = 8453 },
+		"no chain": func(e *SIWEExpectations) { e.ChainID = 0 },
+		"uri":      func(e *SIWEExpectations) { e.URI = "https://service.org/other" },
+	}
+	for name, mutate := range mismatches {
+		expected := siweExpected
+		mutate(&expected)
+		if err := m.Validate(expected, siweNow); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if err := m.Validate(siweExpected, m.ExpirationTime); err == nil {
+		t.Error("expected an error at the expiration time")
+	}
+
+	m.NotBefore = siweNow.Add(time.Minute)
+	if err := m.Validate(siweExpected, siweNow); err == nil {
+		t.Error("expected an error before Not Before")
+	}
+}
+
+func TestVerifySIWE(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	signature, err := sv.SignPersonal(siweExample, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignPersonal: %v", err)
+	}
+
+	m, err := sv.VerifySIWE(siweExample, signature, siweExpected, siweNow)
+	if err != nil {
+		t.Fatalf("VerifySIWE: %v", err)
+	}
+	if m.Address != testAddress {
+		t.Errorf("address = %s, want %s", m.Address, testAddress)
+	}
+
+	otherChain := siweExpected
+	otherChain.ChainID = 8453
+	if _, err := sv.VerifySIWE(siweExample, signature, otherChain, siweNow); err == nil {
+		t.Error("expected an error for a message signed for another chain")
+	}
+
+	if _, err := sv.VerifySIWE(siweExample, signature, siweExpected, siweNow.Add(48*time.Hour)); err == nil {
+		t.Error("expected an error for an expired message")
+	}
+
+	// Signed by someone other than the address in the message
+	otherKey, _, err := sv.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("GeneratePrivateKey: %v", err)
+	}
+	forged, err := sv.SignPersonal(siweExample, otherKey)
+	if err != nil {
+		t.Fatalf("SignPersonal: %v", err)
+	}
+	if _, err := sv.VerifySIWE(siweExample, forged, siweExpected, siweNow); err == nil {
+		t.Error("expected an error for a signature by another account")
+	}
+
+	// A raw (non-personal_sign) signature is rejected
+	raw, err := sv.SignMessage(siweExample, testPrivateKey)
+	if err != nil {
+		t.Fatalf("SignMessage: %v", err)
+	}
+	if _, err := sv.VerifySIWE(siweExample, raw, siweExpected, siweNow); err == nil {
+		t.Error("expected an error for a raw signature")
+	}
+
+	if _, err := sv.VerifySIWE("hello", signature, siweExpected, siweNow); err == nil {
+		t.Error("expected an error for a message that is not SIWE")
+	}
+}
+
+func TestGenerateSIWENonce(t *testing.T) {
+	nonce, err := GenerateSIWENonce()
+	if err != nil {
+		t.Fatalf("GenerateSIWENonce: %v", err)
+	}
+	if len(nonce) != 16 || !validSIWENonce(nonce) {
+		t.Errorf("invalid nonce %q", nonce)
+	}
+
+	other, err := GenerateSIWENonce()
+	if err != nil {
+		t.Fatalf("GenerateSIWENonce: %v", err)
+	}
+	if other == nonce {
+		t.Error("GenerateSIWENonce returned the same nonce twice")
+	}
+}
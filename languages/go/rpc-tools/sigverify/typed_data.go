@@ -0,0 +1,101 @@
+package sigverify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// TypedData is an EIP-712 typed-data document: the type definitions, the
+// primary type, the signing domain and the message
+type TypedData = apitypes.TypedData
+
+// ParseTypedData parses an EIP-712 typed-data JSON document, the format
+// accepted by eth_signTypedData_v4
+func ParseTypedData(data []byte) (*TypedData, error) {
+	var typedData TypedData
+	if err := json.Unmarshal(data, &typedData); err != nil {
+		return nil, fmt.Errorf("failed to parse typed data: %w", err)
+	}
+
+	if typedData.PrimaryType == "" {
+		return nil, errors.New("typed data has no primaryType")
+	}
+	if _, ok := typedData.Types["EIP712Domain"]; !ok {
+		return nil, errors.New("typed data has no EIP712Domain type")
+	}
+	if _, ok := typedData.Types[typedData.PrimaryType]; !ok {
+		return nil, fmt.Errorf("typed data has no definition for primary type %s", typedData.PrimaryType)
+	}
+
+	return &typedData, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator,
+// hashStruct(EIP712Domain)
+func DomainSeparator(typedData *TypedData) (common.Hash, error) {
+	hash, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash domain: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// StructHash returns hashStruct of the typed data's message
+func StructHash(typedData *TypedData) (common.Hash, error) {
+	hash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash %s: %w", typedData.PrimaryType, err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// TypedDataHash returns the digest that is signed for typed data,
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message))
+func TypedDataHash(typedData *TypedData) (common.Hash, error) {
+	domainSeparator, err := DomainSeparator(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	structHash, err := StructHash(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes()), nil
+}
+
+// SignTypedData signs typed data with a private key, like
+// eth_signTypedData_v4
+func (sv *SignatureVerifier) SignTypedData(typedData *TypedData, privateKeyHex string) (string, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return "", err
+	}
+	return signHash(hash.Bytes(), privateKeyHex)
+}
+
+// VerifyTypedData verifies a typed-data signature
+func (sv *SignatureVerifier) VerifyTypedData(typedData *TypedData, signature, address string) (bool, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return false, err
+	}
+	return verifyHash(hash.Bytes(), signature, address)
+}
+
+// RecoverTypedDataSigner returns the address that signed typed data
+func (sv *SignatureVerifier) RecoverTypedDataSigner(typedData *TypedData, signature string) (string, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return "", err
+	}
+	address, err := recoverHash(hash.Bytes(), signature)
+	if err != nil {
+		return "", err
+	}
+	return address.Hex(), nil
+}
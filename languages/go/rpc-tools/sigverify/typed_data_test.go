@@ -0,0 +1,171 @@
+package sigverify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// mailTypedData is the example from EIP-712
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+const (
+	mailSigner    = "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"
+	mailSignature = "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c"
+)
+
+func parseMail(t *testing.T) *TypedData {
+	t.Helper()
+	typedData, err := ParseTypedData([]byte(mailTypedData))
+	if err != nil {
+		t.Fatalf("ParseTypedData: %v", err)
+	}
+	return typedData
+}
+
+func TestParseTypedData(t *testing.T) {
+	typedData := parseMail(t)
+	if typedData.PrimaryType != "Mail" {
+		t.Errorf("primary type = %s, want Mail", typedData.PrimaryType)
+	}
+
+	tests := map[string]string{
+		"invalid json":      `{`,
+		"no primary type":   `{"types": {"EIP712Domain": []}, "domain": {}, "message": {}}`,
+		"no domain type":    `{"types": {"Mail": []}, "primaryType": "Mail", "domain": {}, "message": {}}`,
+		"undefined primary": `{"types": {"EIP712Domain": []}, "primaryType": "Mail", "domain": {}, "message": {}}`,
+	}
+	for name, doc := range tests {
+		if _, err := ParseTypedData([]byte(doc)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestDomainSeparator(t *testing.T) {
+	hash, err := DomainSeparator(parseMail(t))
+	if err != nil {
+		t.Fatalf("DomainSeparator: %v", err)
+	}
+	if want := "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"; hash.Hex() != want {
+		t.Errorf("DomainSeparator = %s, want %s", hash.Hex(), want)
+	}
+}
+
+func TestStructHash(t *testing.T) {
+	hash, err := StructHash(parseMail(t))
+	if err != nil {
+		t.Fatalf("StructHash: %v", err)
+	}
+	if want := "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"; hash.Hex() != want {
+		t.Errorf("StructHash = %s, want %s", hash.Hex(), want)
+	}
+
+	typedData := parseMail(t)
+	typedData.Message["contents"] = 42
+	if _, err := StructHash(typedData); err == nil {
+		t.Error("expected an error for a message that does not match its type")
+	}
+}
+
+func TestTypedDataHash(t *testing.T) {
+	hash, err := TypedDataHash(parseMail(t))
+	if err != nil {
+		t.Fatalf("TypedDataHash: %v", err)
+	}
+	if want := "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"; hash.Hex() != want {
+		t.Errorf("TypedDataHash = %s, want %s", hash.Hex(), want)
+	}
+}
+
+func TestSignTypedData(t *testing.T) {
+	sv := NewSignatureVerifier()
+	cowKey := hexutil.Encode(crypto.Keccak256([]byte("cow")))
+
+	signature, err := sv.SignTypedData(parseMail(t), cowKey)
+	if err != nil {
+		t.Fatalf("SignTypedData: %v", err)
+	}
+	if signature != mailSignature {
+		t.Errorf("SignTypedData = %s, want %s", signature, mailSignature)
+	}
+
+	if _, err := sv.SignTypedData(parseMail(t), "not a key"); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+}
+
+func TestVerifyTypedData(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	valid, err := sv.VerifyTypedData(parseMail(t), mailSignature, mailSigner)
+	if err != nil || !valid {
+		t.Fatalf("VerifyTypedData: valid = %v, err = %v", valid, err)
+	}
+
+	tampered := parseMail(t)
+	tampered.Message["contents"] = "Hello, Alice!"
+	valid, err = sv.VerifyTypedData(tampered, mailSignature, mailSigner)
+	if err != nil {
+		t.Fatalf("VerifyTypedData: %v", err)
+	}
+	if valid {
+		t.Error("signature should not verify for a different message")
+	}
+
+	valid, err = sv.VerifyTypedData(parseMail(t), mailSignature, testAddress)
+	if err != nil {
+		t.Fatalf("VerifyTypedData: %v", err)
+	}
+	if valid {
+		t.Error("signature should not verify for a different address")
+	}
+}
+
+func TestRecoverTypedDataSigner(t *testing.T) {
+	sv := NewSignatureVerifier()
+
+	signer, err := sv.RecoverTypedDataSigner(parseMail(t), mailSignature)
+	if err != nil {
+		t.Fatalf("RecoverTypedDataSigner: %v", err)
+	}
+	if !strings.EqualFold(signer, mailSigner) {
+		t.Errorf("RecoverTypedDataSigner = %s, want %s", signer, mailSigner)
+	}
+
+	if _, err := sv.RecoverTypedDataSigner(parseMail(t), "0x1234"); err == nil {
+		t.Error("expected an error for a short signature")
+	}
+}